@@ -0,0 +1,123 @@
+package client
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/go-resty/resty/v2"
+	httpclient "github.com/itrs-group/terraform-provider-itrs-uptrends/client/httpclient"
+	interfaces "github.com/itrs-group/terraform-provider-itrs-uptrends/client/interfaces"
+	models "github.com/itrs-group/terraform-provider-itrs-uptrends/client/models"
+)
+
+// alertPageSize is the number of alerts requested per page, the maximum the API allows.
+const alertPageSize = 100
+
+// alertMaxPages stops reading alerts when the API keeps returning a Next link, so a misbehaving cursor
+// can't make a read run forever.
+const alertMaxPages = 100
+
+// latestAlertPresetPeriod is the period searched for the latest alert of a monitor: the widest rolling
+// PresetPeriod the Alert endpoints document.
+const latestAlertPresetPeriod = "Last90Days"
+
+var _ interfaces.IAlert = (*Alert)(nil)
+
+// Alert encapsulates the methods to read the alert history.
+type Alert struct {
+	Client  *resty.Client
+	BaseUrl string
+}
+
+// NewAlert creates a new API client instance.
+func NewAlert(baseURL, authHeader, version, platform string) *Alert {
+	client := resty.New()
+	client.SetHeaders(map[string]string{
+		"accept":        "application/json",
+		"Content-Type":  "application/json",
+		"authorization": authHeader,
+	})
+	customHTTPClient := httpclient.NewHTTPClient(version, platform)
+	client.SetTransport(customHTTPClient.Transport)
+	return &Alert{
+		Client:  client,
+		BaseUrl: baseURL,
+	}
+}
+
+// GetMonitorAlerts lists the alerts of a monitor in the time range.
+func (api *Alert) GetMonitorAlerts(monitorGuid string, timeRange models.TimeRange) ([]models.AlertData, int, string, error) {
+	return api.getAlerts(fmt.Sprintf("%s/Monitor/%s", api.BaseUrl, monitorGuid), timeRange)
+}
+
+// GetMonitorGroupAlerts lists the alerts of the monitors in a monitor group in the time range.
+func (api *Alert) GetMonitorGroupAlerts(monitorGroupGuid string, timeRange models.TimeRange) ([]models.AlertData, int, string, error) {
+	return api.getAlerts(fmt.Sprintf("%s/MonitorGroup/%s", api.BaseUrl, monitorGroupGuid), timeRange)
+}
+
+// GetLatestMonitorAlert returns the most recent alert of a monitor in the last 90 days, or nil when the monitor
+// hasn't alerted in that period. It uses the PresetPeriod, Take and Links.Next cursor of
+// GET /Alert/Monitor/{monitorGuid}, as documented in the Uptrends API v4 specification
+// (https://api.uptrends.com/v4/swagger/index.html), and doesn't rely on the order of the alerts.
+func (api *Alert) GetLatestMonitorAlert(monitorGuid string) (*models.AlertData, int, string, error) {
+	alerts, statusCode, responseBody, err := api.GetMonitorAlerts(monitorGuid, models.TimeRange{PresetPeriod: latestAlertPresetPeriod})
+	if err != nil {
+		return nil, statusCode, responseBody, err
+	}
+
+	var latest *models.AlertData
+	for i := range alerts {
+		// Timestamps are returned in the same ISO 8601 format, so they compare as strings.
+		if latest == nil || alerts[i].Attributes.Timestamp >= latest.Attributes.Timestamp {
+			latest = &alerts[i]
+		}
+	}
+	return latest, statusCode, responseBody, nil
+}
+
+// getAlerts reads all pages of alerts from url. It follows the Next link of each page, up to alertMaxPages pages.
+func (api *Alert) getAlerts(url string, timeRange models.TimeRange) ([]models.AlertData, int, string, error) {
+	var alerts []models.AlertData
+
+	request := api.Client.R().
+		SetQueryParams(timeRange.QueryParams()).
+		SetQueryParam("Take", strconv.Itoa(alertPageSize))
+	pageURL := url
+
+	for pages := 1; ; pages++ {
+		var page models.AlertListResponse
+
+		resp, err := request.
+			SetResult(&page).
+			Get(pageURL)
+
+		statusCode := -1
+		responseBody := ""
+		if resp != nil {
+			statusCode = resp.StatusCode()
+			responseBody = resp.String()
+		}
+
+		if err != nil {
+			return nil, statusCode, responseBody, err
+		}
+		if !resp.IsSuccess() {
+			return nil, statusCode, responseBody, fmt.Errorf("failed to list alerts: %s", resp.Status())
+		}
+
+		alerts = append(alerts, page.Data...)
+		if page.Links.Next == "" || len(page.Data) == 0 {
+			return alerts, statusCode, responseBody, nil
+		}
+		if pages == alertMaxPages {
+			return nil, statusCode, responseBody, fmt.Errorf("failed to list alerts: more than %d alerts in the period, choose a shorter period", alertMaxPages*alertPageSize)
+		}
+
+		// The Next link carries the cursor and the time range of the first request.
+		pageURL, err = resolveNextLink(api.BaseUrl, page.Links.Next)
+		if err != nil {
+			return nil, statusCode, responseBody, err
+		}
+		request = api.Client.R()
+	}
+}
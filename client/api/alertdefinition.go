@@ -130,21 +130,27 @@ func (a *AlertDefinition) DeleteAlertDefinition(alertDefinitionGuid string) erro
 }
 
 // We need to fetch the escalation levels to render them within the alert definition resource
-func (a *AlertDefinition) GetEscalationLevels(alertDefinitionGuid string) ([]models.EscalationLevel, error) {
+func (a *AlertDefinition) GetEscalationLevels(alertDefinitionGuid string) ([]models.EscalationLevel, int, error) {
 	var levels []models.EscalationLevel
 	url := fmt.Sprintf("%s/%s/EscalationLevel", a.baseURL, alertDefinitionGuid)
 
 	resp, err := a.client.R().
 		SetResult(&levels).
 		Get(url)
+
+	statusCode := -1
+	if resp != nil {
+		statusCode = resp.StatusCode()
+	}
+
 	if err != nil {
-		return nil, err
+		return nil, statusCode, err
 	}
 	if resp.IsError() {
-		return nil, fmt.Errorf("error fetching escalation levels: %s", resp.Status())
+		return nil, statusCode, fmt.Errorf("error fetching escalation levels: %s", resp.Status())
 	}
 
-	return levels, nil
+	return levels, statusCode, nil
 }
 
 // UpdateEscalationLevel updates an existing escalation level by its AlertDefinition GUID and escalation level ID.
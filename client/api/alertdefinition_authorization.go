@@ -0,0 +1,75 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+	httpclient "github.com/itrs-group/terraform-provider-itrs-uptrends/client/httpclient"
+	interfaces "github.com/itrs-group/terraform-provider-itrs-uptrends/client/interfaces"
+	models "github.com/itrs-group/terraform-provider-itrs-uptrends/client/models"
+)
+
+var _ interfaces.IAlertDefinitionAuthorization = (*AlertDefinitionAuthorization)(nil)
+
+type AlertDefinitionAuthorization struct {
+	client  *resty.Client
+	baseURL string
+}
+
+func NewAlertDefinitionAuthorization(baseURL, authHeader, version, platform string) *AlertDefinitionAuthorization {
+	client := resty.New()
+	client.SetHeaders(map[string]string{
+		"accept":        "application/json",
+		"authorization": authHeader,
+	})
+	customHTTPClient := httpclient.NewHTTPClient(version, platform)
+	client.SetTransport(customHTTPClient.Transport)
+	return &AlertDefinitionAuthorization{
+		client:  client,
+		baseURL: baseURL,
+	}
+}
+
+func (c *AlertDefinitionAuthorization) GetAlertDefinitionAuthorizations(alertDefinitionGuid string) ([]models.AlertDefinitionAuthorization, error) {
+	url := fmt.Sprintf("%s/%s/Authorization", c.baseURL, alertDefinitionGuid)
+	var authorizations []models.AlertDefinitionAuthorization
+	resp, err := c.client.R().
+		SetResult(&authorizations).
+		Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("failed to retrieve alert definition authorizations: %s %s", resp.Status(), resp.Body())
+	}
+	return authorizations, nil
+}
+
+func (c *AlertDefinitionAuthorization) CreateAlertDefinitionAuthorization(alertDefinitionGuid string, auth models.AlertDefinitionAuthorization) (*models.AlertDefinitionAuthorization, error) {
+	url := fmt.Sprintf("%s/%s/Authorization", c.baseURL, alertDefinitionGuid)
+	var created models.AlertDefinitionAuthorization
+	resp, err := c.client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(auth).
+		SetResult(&created).
+		Post(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("failed to create alert definition authorization: %s %s", resp.Status(), resp.Body())
+	}
+	return &created, nil
+}
+
+func (c *AlertDefinitionAuthorization) DeleteAlertDefinitionAuthorization(alertDefinitionGuid, authorizationGuid string) error {
+	url := fmt.Sprintf("%s/%s/Authorization/%s", c.baseURL, alertDefinitionGuid, authorizationGuid)
+	resp, err := c.client.R().Delete(url)
+	if err != nil {
+		return err
+	}
+	if resp.IsError() {
+		return fmt.Errorf("failed to delete alert definition authorization: %s %s", resp.Status(), resp.Body())
+	}
+	return nil
+}
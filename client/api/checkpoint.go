@@ -85,3 +85,28 @@ func (c *Checkpoint) GetCheckpointRegions() ([]models.CheckpointRegionResponse,
 
 	return result, statusCode, responseBody, nil
 }
+
+// GetCheckpointRegionCheckpoints returns the checkpoints that belong to the given checkpoint region.
+func (c *Checkpoint) GetCheckpointRegionCheckpoints(regionId int) (models.CheckpointResponse, int, string, error) {
+	var result models.CheckpointResponse
+
+	resp, err := c.client.R().
+		SetResult(&result).
+		Get(fmt.Sprintf("%s/%d/Checkpoint", c.checkpointRegionURL, regionId))
+
+	statusCode := -1
+	responseBody := ""
+	if resp != nil {
+		statusCode = resp.StatusCode()
+		responseBody = resp.String()
+	}
+
+	if err != nil {
+		return result, statusCode, responseBody, err
+	}
+	if !resp.IsSuccess() {
+		return result, statusCode, responseBody, fmt.Errorf("failed to list checkpoints of region %d: %s", regionId, resp.Status())
+	}
+
+	return result, statusCode, responseBody, nil
+}
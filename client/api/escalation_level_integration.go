@@ -37,6 +37,21 @@ func (c *EscalationLevelIntegration) integrationURL(alertDefinitionGuid string,
 	return fmt.Sprintf("%s/%s/EscalationLevel/%d/Integration", c.baseURL, alertDefinitionGuid, escalationLevelId)
 }
 
+func (c *EscalationLevelIntegration) GetIntegrations(alertDefinitionGuid string, escalationLevelId int) ([]models.EscalationLevelIntegrationResponse, error) {
+	var result []models.EscalationLevelIntegrationResponse
+
+	resp, err := c.client.R().
+		SetResult(&result).
+		Get(c.integrationURL(alertDefinitionGuid, escalationLevelId))
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("error fetching integrations: %s %s", resp.Status(), resp.Body())
+	}
+	return result, nil
+}
+
 func (c *EscalationLevelIntegration) GetIntegration(alertDefinitionGuid string, escalationLevelId int, integrationGuid string) (*models.EscalationLevelIntegrationResponse, error) {
 	var result models.EscalationLevelIntegrationResponse
 	url := fmt.Sprintf("%s/%s", c.integrationURL(alertDefinitionGuid, escalationLevelId), integrationGuid)
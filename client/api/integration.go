@@ -0,0 +1,160 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+	httpclient "github.com/itrs-group/terraform-provider-itrs-uptrends/client/httpclient"
+	interfaces "github.com/itrs-group/terraform-provider-itrs-uptrends/client/interfaces"
+	models "github.com/itrs-group/terraform-provider-itrs-uptrends/client/models"
+)
+
+var _ interfaces.IIntegration = (*Integration)(nil)
+
+// Integration encapsulates the methods to interact with the Integration API.
+type Integration struct {
+	Client  *resty.Client
+	BaseUrl string
+}
+
+// NewIntegration creates a new API client instance.
+func NewIntegration(baseURL, authHeader, version, platform string) *Integration {
+	client := resty.New()
+	client.SetHeaders(map[string]string{
+		"accept":        "application/json",
+		"Content-Type":  "application/json",
+		"authorization": authHeader,
+	})
+	customHTTPClient := httpclient.NewHTTPClient(version, platform)
+	client.SetTransport(customHTTPClient.Transport)
+	return &Integration{
+		Client:  client,
+		BaseUrl: baseURL,
+	}
+}
+
+// GetIntegrations lists all integrations of the account.
+func (api *Integration) GetIntegrations() ([]models.IntegrationResponse, int, string, error) {
+	var integrations []models.IntegrationResponse
+
+	resp, err := api.Client.R().
+		SetResult(&integrations).
+		Get(api.BaseUrl)
+
+	statusCode := -1
+	responseBody := ""
+	if resp != nil {
+		statusCode = resp.StatusCode()
+		responseBody = resp.String()
+	}
+
+	if err != nil {
+		return nil, statusCode, responseBody, err
+	}
+	if !resp.IsSuccess() {
+		return nil, statusCode, responseBody, fmt.Errorf("failed to list integrations: %s", resp.Status())
+	}
+
+	return integrations, statusCode, responseBody, nil
+}
+
+// GetIntegration retrieves a specific integration by its GUID.
+func (api *Integration) GetIntegration(integrationGuid string) (*models.IntegrationResponse, int, string, error) {
+	var integration models.IntegrationResponse
+	url := fmt.Sprintf("%s/%s", api.BaseUrl, integrationGuid)
+
+	resp, err := api.Client.R().
+		SetResult(&integration).
+		Get(url)
+
+	statusCode := -1
+	responseBody := ""
+	if resp != nil {
+		statusCode = resp.StatusCode()
+		responseBody = resp.String()
+	}
+
+	if err != nil {
+		return nil, statusCode, responseBody, err
+	}
+	if !resp.IsSuccess() {
+		return nil, statusCode, responseBody, fmt.Errorf("failed to get integration: %s", resp.Status())
+	}
+
+	return &integration, statusCode, responseBody, nil
+}
+
+// CreateIntegration creates a new integration.
+func (api *Integration) CreateIntegration(request models.IntegrationRequest) (*models.IntegrationResponse, int, string, error) {
+	var integration models.IntegrationResponse
+
+	resp, err := api.Client.R().
+		SetBody(request).
+		SetResult(&integration).
+		Post(api.BaseUrl)
+
+	statusCode := -1
+	responseBody := ""
+	if resp != nil {
+		statusCode = resp.StatusCode()
+		responseBody = resp.String()
+	}
+
+	if err != nil {
+		return nil, statusCode, responseBody, err
+	}
+	if !resp.IsSuccess() {
+		return nil, statusCode, responseBody, fmt.Errorf("failed to create integration: %s", resp.Status())
+	}
+
+	return &integration, statusCode, responseBody, nil
+}
+
+// UpdateIntegration updates an existing integration.
+func (api *Integration) UpdateIntegration(integrationGuid string, request models.IntegrationRequest) (int, string, error) {
+	url := fmt.Sprintf("%s/%s", api.BaseUrl, integrationGuid)
+
+	resp, err := api.Client.R().
+		SetBody(request).
+		Patch(url)
+
+	statusCode := -1
+	responseBody := ""
+	if resp != nil {
+		statusCode = resp.StatusCode()
+		responseBody = resp.String()
+	}
+
+	if err != nil {
+		return statusCode, responseBody, err
+	}
+	if !resp.IsSuccess() {
+		return statusCode, responseBody, fmt.Errorf("failed to update integration: %s", resp.Status())
+	}
+
+	return statusCode, responseBody, nil
+}
+
+// DeleteIntegration deletes an integration.
+func (api *Integration) DeleteIntegration(integrationGuid string) (int, string, error) {
+	url := fmt.Sprintf("%s/%s", api.BaseUrl, integrationGuid)
+
+	resp, err := api.Client.R().
+		Delete(url)
+
+	statusCode := -1
+	responseBody := ""
+	if resp != nil {
+		statusCode = resp.StatusCode()
+		responseBody = resp.String()
+	}
+
+	if err != nil {
+		return statusCode, responseBody, err
+	}
+	if !resp.IsSuccess() {
+		return statusCode, responseBody, fmt.Errorf("failed to delete integration: %s", resp.Status())
+	}
+
+	return statusCode, responseBody, nil
+}
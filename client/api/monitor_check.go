@@ -0,0 +1,97 @@
+package client
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/go-resty/resty/v2"
+	httpclient "github.com/itrs-group/terraform-provider-itrs-uptrends/client/httpclient"
+	interfaces "github.com/itrs-group/terraform-provider-itrs-uptrends/client/interfaces"
+	models "github.com/itrs-group/terraform-provider-itrs-uptrends/client/models"
+)
+
+// monitorCheckPageSize is the number of checks requested per page, the maximum the API allows.
+const monitorCheckPageSize = 100
+
+var _ interfaces.IMonitorCheck = (*MonitorCheck)(nil)
+
+// MonitorCheck encapsulates the methods to read the check results of monitors.
+type MonitorCheck struct {
+	Client  *resty.Client
+	BaseUrl string
+}
+
+// NewMonitorCheck creates a new API client instance.
+func NewMonitorCheck(baseURL, authHeader, version, platform string) *MonitorCheck {
+	client := resty.New()
+	client.SetHeaders(map[string]string{
+		"accept":        "application/json",
+		"Content-Type":  "application/json",
+		"authorization": authHeader,
+	})
+	customHTTPClient := httpclient.NewHTTPClient(version, platform)
+	client.SetTransport(customHTTPClient.Transport)
+	return &MonitorCheck{
+		Client:  client,
+		BaseUrl: baseURL,
+	}
+}
+
+// GetMonitorChecks lists the checks of a monitor in the time range. It follows the Next link of each page
+// until all checks are read.
+func (api *MonitorCheck) GetMonitorChecks(monitorGuid string, timeRange models.TimeRange) ([]models.MonitorCheckData, int, string, error) {
+	var checks []models.MonitorCheckData
+
+	request := api.Client.R().
+		SetQueryParams(timeRange.QueryParams()).
+		SetQueryParam("Take", strconv.Itoa(monitorCheckPageSize))
+	pageURL := fmt.Sprintf("%s/Monitor/%s", api.BaseUrl, monitorGuid)
+
+	for {
+		var page models.MonitorCheckListResponse
+
+		resp, err := request.
+			SetResult(&page).
+			Get(pageURL)
+
+		statusCode := -1
+		responseBody := ""
+		if resp != nil {
+			statusCode = resp.StatusCode()
+			responseBody = resp.String()
+		}
+
+		if err != nil {
+			return nil, statusCode, responseBody, err
+		}
+		if !resp.IsSuccess() {
+			return nil, statusCode, responseBody, fmt.Errorf("failed to list monitor checks: %s", resp.Status())
+		}
+
+		checks = append(checks, page.Data...)
+		if page.Links.Next == "" || len(page.Data) == 0 {
+			return checks, statusCode, responseBody, nil
+		}
+
+		// The Next link carries the cursor and the time range of the first request.
+		pageURL, err = resolveNextLink(api.BaseUrl, page.Links.Next)
+		if err != nil {
+			return nil, statusCode, responseBody, err
+		}
+		request = api.Client.R()
+	}
+}
+
+// resolveNextLink turns the Next link of a page, which may be relative to the API host, into an absolute URL.
+func resolveNextLink(baseURL, next string) (string, error) {
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid base URL %q: %v", baseURL, err)
+	}
+	link, err := url.Parse(next)
+	if err != nil {
+		return "", fmt.Errorf("invalid Next link %q: %v", next, err)
+	}
+	return base.ResolveReference(link).String(), nil
+}
@@ -121,3 +121,74 @@ func (a *Operator) GetOperators() ([]models.OperatorResponse, int, string, error
 	}
 	return operators, statusCode, responseBody, nil
 }
+
+// GetDutySchedules lists the duty schedules of an operator.
+func (a *Operator) GetDutySchedules(operatorID string) ([]models.OperatorDutySchedule, int, string, error) {
+	var schedules []models.OperatorDutySchedule
+	url := a.BaseUrl + "/" + operatorID + "/DutySchedule"
+	resp, err := a.Client.R().
+		SetResult(&schedules).
+		Get(url)
+
+	statusCode := -1
+	responseBody := ""
+	if resp != nil {
+		statusCode = resp.StatusCode()
+		responseBody = resp.String()
+	}
+
+	if err != nil {
+		return nil, statusCode, responseBody, err
+	}
+	if !resp.IsSuccess() {
+		return nil, statusCode, responseBody, fmt.Errorf("failed to get duty schedules: %s", resp.Status())
+	}
+	return schedules, statusCode, responseBody, nil
+}
+
+// CreateDutySchedule adds a duty schedule to an operator.
+func (a *Operator) CreateDutySchedule(operatorID string, schedule models.OperatorDutySchedule) (models.OperatorDutySchedule, int, string, error) {
+	var created models.OperatorDutySchedule
+	url := a.BaseUrl + "/" + operatorID + "/DutySchedule"
+	resp, err := a.Client.R().
+		SetBody(schedule).
+		SetResult(&created).
+		Post(url)
+
+	statusCode := -1
+	responseBody := ""
+	if resp != nil {
+		statusCode = resp.StatusCode()
+		responseBody = resp.String()
+	}
+
+	if err != nil {
+		return models.OperatorDutySchedule{}, statusCode, responseBody, err
+	}
+	if !resp.IsSuccess() {
+		return models.OperatorDutySchedule{}, statusCode, responseBody, fmt.Errorf("failed to create duty schedule: %s", resp.Status())
+	}
+	return created, statusCode, responseBody, nil
+}
+
+// DeleteDutySchedule removes a duty schedule from an operator.
+func (a *Operator) DeleteDutySchedule(operatorID string, scheduleID int) (int, string, error) {
+	url := fmt.Sprintf("%s/%s/DutySchedule/%d", a.BaseUrl, operatorID, scheduleID)
+	resp, err := a.Client.R().
+		Delete(url)
+
+	statusCode := -1
+	responseBody := ""
+	if resp != nil {
+		statusCode = resp.StatusCode()
+		responseBody = resp.String()
+	}
+
+	if err != nil {
+		return statusCode, responseBody, err
+	}
+	if !resp.IsSuccess() {
+		return statusCode, responseBody, fmt.Errorf("failed to delete duty schedule: %s", resp.Status())
+	}
+	return statusCode, responseBody, nil
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+	httpclient "github.com/itrs-group/terraform-provider-itrs-uptrends/client/httpclient"
+	interfaces "github.com/itrs-group/terraform-provider-itrs-uptrends/client/interfaces"
+	models "github.com/itrs-group/terraform-provider-itrs-uptrends/client/models"
+)
+
+var _ interfaces.IStatistics = (*Statistics)(nil)
+
+// Statistics encapsulates the methods to read the statistics of monitors and monitor groups.
+type Statistics struct {
+	Client  *resty.Client
+	BaseUrl string
+}
+
+// NewStatistics creates a new API client instance.
+func NewStatistics(baseURL, authHeader, version, platform string) *Statistics {
+	client := resty.New()
+	client.SetHeaders(map[string]string{
+		"accept":        "application/json",
+		"Content-Type":  "application/json",
+		"authorization": authHeader,
+	})
+	customHTTPClient := httpclient.NewHTTPClient(version, platform)
+	client.SetTransport(customHTTPClient.Transport)
+	return &Statistics{
+		Client:  client,
+		BaseUrl: baseURL,
+	}
+}
+
+// GetMonitorStatistics reads the statistics of a monitor in the time range, per dimension period.
+func (api *Statistics) GetMonitorStatistics(monitorGuid string, timeRange models.TimeRange, dimension string) ([]models.StatisticsData, int, string, error) {
+	return api.getStatistics(fmt.Sprintf("%s/Monitor/%s", api.BaseUrl, monitorGuid), timeRange, dimension)
+}
+
+// GetMonitorGroupStatistics reads the statistics of a monitor group in the time range, per dimension period.
+func (api *Statistics) GetMonitorGroupStatistics(monitorGroupGuid string, timeRange models.TimeRange, dimension string) ([]models.StatisticsData, int, string, error) {
+	return api.getStatistics(fmt.Sprintf("%s/MonitorGroup/%s", api.BaseUrl, monitorGroupGuid), timeRange, dimension)
+}
+
+func (api *Statistics) getStatistics(url string, timeRange models.TimeRange, dimension string) ([]models.StatisticsData, int, string, error) {
+	var statistics models.StatisticsListResponse
+
+	resp, err := api.Client.R().
+		SetQueryParams(timeRange.QueryParams()).
+		SetQueryParam("Dimension", dimension).
+		SetResult(&statistics).
+		Get(url)
+
+	statusCode := -1
+	responseBody := ""
+	if resp != nil {
+		statusCode = resp.StatusCode()
+		responseBody = resp.String()
+	}
+
+	if err != nil {
+		return nil, statusCode, responseBody, err
+	}
+	if !resp.IsSuccess() {
+		return nil, statusCode, responseBody, fmt.Errorf("failed to get statistics: %s", resp.Status())
+	}
+
+	return statistics.Data, statusCode, responseBody, nil
+}
@@ -0,0 +1,12 @@
+package client
+
+import (
+	models "github.com/itrs-group/terraform-provider-itrs-uptrends/client/models"
+)
+
+// IAlert defines the interface for reading the alert history.
+type IAlert interface {
+	GetMonitorAlerts(monitorGuid string, timeRange models.TimeRange) ([]models.AlertData, int, string, error)
+	GetMonitorGroupAlerts(monitorGroupGuid string, timeRange models.TimeRange) ([]models.AlertData, int, string, error)
+	GetLatestMonitorAlert(monitorGuid string) (*models.AlertData, int, string, error)
+}
@@ -11,5 +11,5 @@ type IAlertDefinition interface {
 	UpdateAlertDefinition(alertDefinitionGuid string, payload models.AlertDefinitionRequest) error
 	DeleteAlertDefinition(alertDefinitionGuid string) error
 	UpdateEscalationLevel(payload models.EscalationLevel) error
-	GetEscalationLevels(alertDefinitionGuid string) ([]models.EscalationLevel, error)
+	GetEscalationLevels(alertDefinitionGuid string) ([]models.EscalationLevel, int, error)
 }
@@ -0,0 +1,9 @@
+package client
+
+import models "github.com/itrs-group/terraform-provider-itrs-uptrends/client/models"
+
+type IAlertDefinitionAuthorization interface {
+	GetAlertDefinitionAuthorizations(alertDefinitionGuid string) ([]models.AlertDefinitionAuthorization, error)
+	CreateAlertDefinitionAuthorization(alertDefinitionGuid string, auth models.AlertDefinitionAuthorization) (*models.AlertDefinitionAuthorization, error)
+	DeleteAlertDefinitionAuthorization(alertDefinitionGuid, authorizationGuid string) error
+}
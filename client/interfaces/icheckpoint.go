@@ -8,4 +8,5 @@ import (
 type ICheckpoint interface {
 	GetCheckpoints() (models.CheckpointResponse, int, string, error)
 	GetCheckpointRegions() ([]models.CheckpointRegionResponse, int, string, error)
+	GetCheckpointRegionCheckpoints(regionId int) (models.CheckpointResponse, int, string, error)
 }
@@ -5,6 +5,7 @@ import (
 )
 
 type IEscalationLevelIntegration interface {
+	GetIntegrations(alertDefinitionGuid string, escalationLevelId int) ([]models.EscalationLevelIntegrationResponse, error)
 	GetIntegration(alertDefinitionGuid string, escalationLevelId int, integrationGuid string) (*models.EscalationLevelIntegrationResponse, error)
 	AddIntegration(alertDefinitionGuid string, escalationLevelId int, payload models.EscalationLevelIntegrationRequest) (*models.EscalationLevelIntegrationResponse, error)
 	UpdateIntegration(alertDefinitionGuid string, escalationLevelId int, integrationGuid string, payload models.EscalationLevelIntegrationRequest) error
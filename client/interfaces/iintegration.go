@@ -0,0 +1,14 @@
+package client
+
+import (
+	models "github.com/itrs-group/terraform-provider-itrs-uptrends/client/models"
+)
+
+// IIntegration defines the interface for managing integrations.
+type IIntegration interface {
+	GetIntegrations() ([]models.IntegrationResponse, int, string, error)
+	GetIntegration(integrationGuid string) (*models.IntegrationResponse, int, string, error)
+	CreateIntegration(request models.IntegrationRequest) (*models.IntegrationResponse, int, string, error)
+	UpdateIntegration(integrationGuid string, request models.IntegrationRequest) (int, string, error)
+	DeleteIntegration(integrationGuid string) (int, string, error)
+}
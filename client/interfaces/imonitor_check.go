@@ -0,0 +1,10 @@
+package client
+
+import (
+	models "github.com/itrs-group/terraform-provider-itrs-uptrends/client/models"
+)
+
+// IMonitorCheck defines the interface for reading the check results of monitors.
+type IMonitorCheck interface {
+	GetMonitorChecks(monitorGuid string, timeRange models.TimeRange) ([]models.MonitorCheckData, int, string, error)
+}
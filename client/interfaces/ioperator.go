@@ -10,4 +10,7 @@ type IOperator interface {
 	UpdateOperator(operatorID string, requestBody models.OperatorRequest) (int, string, error)
 	CreateOperator(requestData models.OperatorRequest) (models.OperatorResponse, int, string, error)
 	DeleteOperator(operatorID string) (int, string, error)
+	GetDutySchedules(operatorID string) ([]models.OperatorDutySchedule, int, string, error)
+	CreateDutySchedule(operatorID string, schedule models.OperatorDutySchedule) (models.OperatorDutySchedule, int, string, error)
+	DeleteDutySchedule(operatorID string, scheduleID int) (int, string, error)
 }
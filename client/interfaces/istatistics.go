@@ -0,0 +1,11 @@
+package client
+
+import (
+	models "github.com/itrs-group/terraform-provider-itrs-uptrends/client/models"
+)
+
+// IStatistics defines the interface for reading the statistics of monitors and monitor groups.
+type IStatistics interface {
+	GetMonitorStatistics(monitorGuid string, timeRange models.TimeRange, dimension string) ([]models.StatisticsData, int, string, error)
+	GetMonitorGroupStatistics(monitorGroupGuid string, timeRange models.TimeRange, dimension string) ([]models.StatisticsData, int, string, error)
+}
@@ -0,0 +1,47 @@
+package client
+
+// TimeRange selects the period of a history request. Either PresetPeriod or Start and End are set.
+type TimeRange struct {
+	Start        string
+	End          string
+	PresetPeriod string
+}
+
+// QueryParams returns the query string parameters of the time range.
+func (t TimeRange) QueryParams() map[string]string {
+	params := map[string]string{}
+	if t.PresetPeriod != "" {
+		params["PresetPeriod"] = t.PresetPeriod
+	}
+	if t.Start != "" {
+		params["Start"] = t.Start
+	}
+	if t.End != "" {
+		params["End"] = t.End
+	}
+	return params
+}
+
+// AlertListResponse is a page of alerts returned by the Alert endpoints.
+type AlertListResponse struct {
+	Data  []AlertData `json:"Data"`
+	Links struct {
+		Next string `json:"Next,omitempty"`
+	} `json:"Links"`
+}
+
+type AlertData struct {
+	Id         string          `json:"Id"`
+	Type       string          `json:"Type"`
+	Attributes AlertAttributes `json:"Attributes"`
+}
+
+type AlertAttributes struct {
+	Timestamp        string `json:"Timestamp"`
+	MonitorGuid      string `json:"MonitorGuid"`
+	AlertType        string `json:"AlertType"`
+	EscalationLevel  int    `json:"EscalationLevel"`
+	ErrorDescription string `json:"ErrorDescription,omitempty"`
+	FirstError       string `json:"FirstError,omitempty"`
+	IncidentKey      string `json:"IncidentKey,omitempty"`
+}
@@ -0,0 +1,9 @@
+package client
+
+// AlertDefinitionAuthorization represents an alert definition authorization in the API.
+type AlertDefinitionAuthorization struct {
+	AuthorizationId   string `json:"AuthorizationId,omitempty"`
+	AuthorizationType string `json:"AuthorizationType"`
+	OperatorGuid      string `json:"OperatorGuid,omitempty"`
+	OperatorGroupGuid string `json:"OperatorGroupGuid,omitempty"`
+}
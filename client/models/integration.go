@@ -0,0 +1,44 @@
+package client
+
+// IntegrationResponse represents an integration, the channel an escalation level sends its alerts to.
+// Secrets such as webhook URLs of Slack and Microsoft Teams, PagerDuty integration keys and
+// Statushub API keys are never returned by the API.
+type IntegrationResponse struct {
+	IntegrationGuid     string                `json:"IntegrationGuid"`
+	Name                string                `json:"Name"`
+	Type                string                `json:"Type"`
+	Url                 string                `json:"Url,omitempty"`        // for integration type: "GenericWebhook"
+	HttpMethod          string                `json:"HttpMethod,omitempty"` // for integration type: "GenericWebhook"
+	CustomBody          string                `json:"CustomBody,omitempty"` // for integration type: "GenericWebhook"
+	HubName             string                `json:"HubName,omitempty"`    // for integration type: "Statushub"
+	Variables           []IntegrationVariable `json:"Variables,omitempty"`
+	IntegrationServices []IntegrationService  `json:"IntegrationServices,omitempty"` // for integration type: "Statushub"
+	Hash                string                `json:"Hash,omitempty"`
+}
+
+// IntegrationRequest is the payload to create or update an integration.
+type IntegrationRequest struct {
+	Name               string                `json:"Name"`
+	Type               string                `json:"Type"`
+	Url                *string               `json:"Url,omitempty"`                // for integration type: "GenericWebhook"
+	HttpMethod         *string               `json:"HttpMethod,omitempty"`         // for integration type: "GenericWebhook"
+	CustomBody         *string               `json:"CustomBody,omitempty"`         // for integration type: "GenericWebhook"
+	IncomingWebhookUrl *string               `json:"IncomingWebhookUrl,omitempty"` // for integration type: "Slack", "MicrosoftTeams"
+	IntegrationKey     *string               `json:"IntegrationKey,omitempty"`     // for integration type: "PagerDuty"
+	ApiKey             *string               `json:"ApiKey,omitempty"`             // for integration type: "Statushub"
+	HubName            *string               `json:"HubName,omitempty"`            // for integration type: "Statushub"
+	Variables          []IntegrationVariable `json:"Variables"`
+}
+
+// IntegrationVariable is a variable of an integration that escalation levels can set through VariableValues.
+type IntegrationVariable struct {
+	Name         string `json:"Name"`
+	DefaultValue string `json:"DefaultValue,omitempty"`
+	IsRequired   bool   `json:"IsRequired"`
+}
+
+// IntegrationService is a Statushub service that monitors can be mapped to.
+type IntegrationService struct {
+	IntegrationServiceGuid string `json:"IntegrationServiceGuid"`
+	Name                   string `json:"Name"`
+}
@@ -50,6 +50,7 @@ type MonitorRequest struct {
 	DatabaseName                        *string                  `json:"DatabaseName,omitempty"`
 	NetworkAddress                      *string                  `json:"NetworkAddress,omitempty"`
 	ImapSecureConnection                *bool                    `json:"ImapSecureConnection,omitempty"`
+	Pop3SecureConnection                *bool                    `json:"Pop3SecureConnection,omitempty"`
 	SftpAction                          *string                  `json:"SftpAction,omitempty"`
 	SftpActionPath                      *string                  `json:"SftpActionPath,omitempty"`
 	HttpMethod                          *string                  `json:"HttpMethod,omitempty"`
@@ -119,6 +120,7 @@ type MonitorResponse struct {
 	DatabaseName                        *string                  `json:"DatabaseName,omitempty"`
 	NetworkAddress                      *string                  `json:"NetworkAddress,omitempty"`
 	ImapSecureConnection                *bool                    `json:"ImapSecureConnection,omitempty"`
+	Pop3SecureConnection                *bool                    `json:"Pop3SecureConnection,omitempty"`
 	SftpAction                          *string                  `json:"SftpAction,omitempty"`
 	SftpActionPath                      *string                  `json:"SftpActionPath,omitempty"`
 	HttpMethod                          *string                  `json:"HttpMethod,omitempty"`
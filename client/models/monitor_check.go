@@ -0,0 +1,33 @@
+package client
+
+// MonitorCheckListResponse is a page of checks returned by the MonitorCheck endpoints.
+type MonitorCheckListResponse struct {
+	Data  []MonitorCheckData `json:"Data"`
+	Links struct {
+		Next string `json:"Next,omitempty"`
+	} `json:"Links"`
+}
+
+type MonitorCheckData struct {
+	Id         int64                  `json:"Id"`
+	Type       string                 `json:"Type"`
+	Attributes MonitorCheckAttributes `json:"Attributes"`
+}
+
+type MonitorCheckAttributes struct {
+	MonitorGuid       string `json:"MonitorGuid"`
+	Timestamp         string `json:"Timestamp"`
+	ErrorCode         int    `json:"ErrorCode"`
+	ErrorLevel        string `json:"ErrorLevel"`
+	TotalTime         int64  `json:"TotalTime"`
+	ResolveTime       int64  `json:"ResolveTime"`
+	ConnectionTime    int64  `json:"ConnectionTime"`
+	DownloadTime      int64  `json:"DownloadTime"`
+	HttpStatusCode    int    `json:"HttpStatusCode"`
+	CheckpointId      int    `json:"CheckpointId"`
+	ServerId          int    `json:"ServerId"`
+	ResultDescription string `json:"ResultDescription,omitempty"`
+	ErrorDescription  string `json:"ErrorDescription,omitempty"`
+	ErrorMessage      string `json:"ErrorMessage,omitempty"`
+	IsConcurrentCheck bool   `json:"IsConcurrentCheck"`
+}
@@ -0,0 +1,12 @@
+package client
+
+// OperatorDutySchedule is a duty period of an operator, as used by the Operator DutySchedule endpoints.
+// Days and times are in the time zone of the operator.
+type OperatorDutySchedule struct {
+	Id         int    `json:"Id,omitempty"`
+	Recurrence string `json:"Recurrence"`
+	StartDay   string `json:"StartDay,omitempty"`
+	StartTime  string `json:"StartTime,omitempty"`
+	EndDay     string `json:"EndDay,omitempty"`
+	EndTime    string `json:"EndTime,omitempty"`
+}
@@ -0,0 +1,27 @@
+package client
+
+// StatisticsListResponse holds the statistics returned by the Statistics endpoints, one entry per dimension period.
+type StatisticsListResponse struct {
+	Data []StatisticsData `json:"Data"`
+}
+
+type StatisticsData struct {
+	Id         string               `json:"Id"`
+	Type       string               `json:"Type"`
+	Attributes StatisticsAttributes `json:"Attributes"`
+}
+
+type StatisticsAttributes struct {
+	Alerts             int64   `json:"Alerts"`
+	Checks             int64   `json:"Checks"`
+	ConfirmedErrors    int64   `json:"ConfirmedErrors"`
+	UnconfirmedErrors  int64   `json:"UnconfirmedErrors"`
+	Uptime             float64 `json:"Uptime"`
+	UptimePercentage   float64 `json:"UptimePercentage"`
+	Downtime           float64 `json:"Downtime"`
+	DowntimePercentage float64 `json:"DowntimePercentage"`
+	TotalTime          float64 `json:"TotalTime"`
+	ResolveTime        float64 `json:"ResolveTime"`
+	ConnectionTime     float64 `json:"ConnectionTime"`
+	DownloadTime       float64 `json:"DownloadTime"`
+}
@@ -1,5 +1,8 @@
 package client
 
+// DefaultBaseUrl is the Uptrends API URL used when no custom URL is configured.
+const DefaultBaseUrl = "https://api.uptrends.com/v4"
+
 // UrlSource holds the configurable base URL for the API.
 type UrlSource struct {
 	baseURL string
@@ -64,3 +67,23 @@ func (c *UrlSource) VaultSectionURL() string {
 func (c *UrlSource) RumWebsiteURL() string {
 	return c.baseURL + "/Rum/Website"
 }
+
+// IntegrationURL returns the full URL for the Integration endpoint.
+func (c *UrlSource) IntegrationURL() string {
+	return c.baseURL + "/Integration"
+}
+
+// AlertURL returns the full URL for the Alert endpoint.
+func (c *UrlSource) AlertURL() string {
+	return c.baseURL + "/Alert"
+}
+
+// MonitorCheckURL returns the full URL for the MonitorCheck endpoint.
+func (c *UrlSource) MonitorCheckURL() string {
+	return c.baseURL + "/MonitorCheck"
+}
+
+// StatisticsURL returns the full URL for the Statistics endpoint.
+func (c *UrlSource) StatisticsURL() string {
+	return c.baseURL + "/Statistics"
+}
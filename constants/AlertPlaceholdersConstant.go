@@ -0,0 +1,29 @@
+package constants
+
+import "github.com/itrs-group/terraform-provider-itrs-uptrends/helpers"
+
+// AlertPlaceholders is the set of documented placeholders that Uptrends fills in in escalation level messages
+// and in the body templates of webhook integrations, with the example values used by render_alert_message.
+var AlertPlaceholders = []helpers.AlertPlaceholder{
+	{Name: "@alert.type", Description: "Type of the alert: Error or Ok.", Example: "Error"},
+	{Name: "@alert.alertGuid", Description: "GUID of the alert.", Example: "0e1b8b2c-6b3e-4a5e-9f3a-2d6c1f7a9b01"},
+	{Name: "@alert.description", Description: "Description of the alert.", Example: "Error alert for monitor Webshop"},
+	{Name: "@alert.errorDescription", Description: "Description of the error that caused the alert.", Example: "HTTP status 503 (Service Unavailable)"},
+	{Name: "@alert.errorCount", Description: "Number of consecutive errors.", Example: "3"},
+	{Name: "@alert.escalationLevel", Description: "Escalation level that sent the alert.", Example: "1"},
+	{Name: "@alert.incidentKey", Description: "Key that ties the Error and Ok alerts of one incident together.", Example: "4f6d2a8e-1c3b-4e5f-8a9b-0c1d2e3f4a5b"},
+	{Name: "@alert.timestamp", Description: "Time of the alert in the time zone of the account.", Example: "2024-05-01T03:12:45"},
+	{Name: "@alert.utcTimestamp", Description: "Time of the alert in UTC.", Example: "2024-05-01T01:12:45Z"},
+	{Name: "@alert.firstError", Description: "Time of the first error in the time zone of the account.", Example: "2024-05-01T03:02:45"},
+	{Name: "@alert.firstErrorUtc", Description: "Time of the first error in UTC.", Example: "2024-05-01T01:02:45Z"},
+	{Name: "@alert.definitionGuid", Description: "GUID of the alert definition.", Example: "046a727c-7a90-4776-9e41-ab050bdda5dc"},
+	{Name: "@alert.definitionName", Description: "Name of the alert definition.", Example: "Webshop alerts"},
+	{Name: "@monitor.monitorGuid", Description: "GUID of the monitor.", Example: "9c2f4d6e-8a1b-4c3d-9e5f-7a8b9c0d1e2f"},
+	{Name: "@monitor.name", Description: "Name of the monitor.", Example: "Webshop"},
+	{Name: "@monitor.type", Description: "Type of the monitor.", Example: "Https"},
+	{Name: "@monitor.url", Description: "URL or network address checked by the monitor.", Example: "https://shop.example.com"},
+	{Name: "@monitor.notes", Description: "Notes of the monitor.", Example: "Owned by the webshop team"},
+	{Name: "@monitor.dashboardUrl", Description: "Link to the monitor in the Uptrends app.", Example: "https://app.uptrends.com/Report/Monitor/9c2f4d6e-8a1b-4c3d-9e5f-7a8b9c0d1e2f"},
+	{Name: "@account.id", Description: "ID of the Uptrends account.", Example: "123456"},
+	{Name: "@account.name", Description: "Name of the Uptrends account.", Example: "Example Inc."},
+}
@@ -0,0 +1,40 @@
+package constants
+
+import "github.com/itrs-group/terraform-provider-itrs-uptrends/helpers"
+
+// The ranges are the check interval choices the Uptrends application offers when editing a monitor:
+// 1 to 60 minutes for most monitor types, and 5 to 60 minutes for the browser based types
+// (FullPageCheck and Transaction). The API specification doesn't list them, so update these
+// rules when Uptrends changes the choices in the application.
+var basicCheckIntervalRule = helpers.CheckIntervalRule{
+	MinimumSeconds: 60,
+	MaximumSeconds: 3600,
+}
+
+var browserCheckIntervalRule = helpers.CheckIntervalRule{
+	MinimumSeconds: 300,
+	MaximumSeconds: 3600,
+}
+
+// CheckIntervalRules defines, per monitor type, the allowed range for check_interval and check_interval_seconds.
+var CheckIntervalRules = map[string]helpers.CheckIntervalRule{
+	"Http":            basicCheckIntervalRule,
+	"Https":           basicCheckIntervalRule,
+	"Connect":         basicCheckIntervalRule,
+	"Ping":            basicCheckIntervalRule,
+	"POP3":            basicCheckIntervalRule,
+	"SMTP":            basicCheckIntervalRule,
+	"FTP":             basicCheckIntervalRule,
+	"MySQL":           basicCheckIntervalRule,
+	"MSSQL":           basicCheckIntervalRule,
+	"WebserviceHttp":  basicCheckIntervalRule,
+	"WebserviceHttps": basicCheckIntervalRule,
+	"DNS":             basicCheckIntervalRule,
+	"SFTP":            basicCheckIntervalRule,
+	"IMAP":            basicCheckIntervalRule,
+	"MultiStepApi":    basicCheckIntervalRule,
+	"PostmanApi":      basicCheckIntervalRule,
+	"Certificate":     basicCheckIntervalRule,
+	"FullPageCheck":   browserCheckIntervalRule,
+	"Transaction":     browserCheckIntervalRule,
+}
@@ -0,0 +1,157 @@
+package constants
+
+import "github.com/itrs-group/terraform-provider-itrs-uptrends/helpers"
+
+var httpMonitorTypes = []string{
+	"Http",
+	"Https",
+	"WebserviceHttp",
+	"WebserviceHttps",
+}
+
+var browserMonitorTypes = []string{
+	"FullPageCheck",
+	"Transaction",
+}
+
+var loadTimeMonitorTypes = append(append([]string{
+	"Certificate",
+	"DNS",
+	"SFTP",
+	"FTP",
+	"SMTP",
+	"POP3",
+	"IMAP",
+	"MSSQL",
+	"MySQL",
+	"Ping",
+	"Connect",
+}, httpMonitorTypes...), browserMonitorTypes...)
+
+var browserMetricRule = helpers.ErrorConditionRule{
+	ResourceAttributes: helpers.ResourceAttributes{
+		RequiredAttributes: []string{"value"},
+		OptionalAttributes: []string{"effect"},
+	},
+	ValueFormat:  helpers.ValueFormatInteger,
+	MonitorTypes: browserMonitorTypes,
+}
+
+// ErrorConditionAttributes defines, per error_condition_type, which sub-fields of an error condition are
+// required and allowed, the format of its value and the monitor types that support it.
+// The error_condition_type itself is always required and therefore not listed.
+// Uptrends doesn't publish a complete list of the monitor types and attributes per error condition type,
+// so the monitor resource only warns about combinations that aren't in this table.
+var ErrorConditionAttributes = map[string]helpers.ErrorConditionRule{
+	"LoadTimeLimit1": {
+		ResourceAttributes: helpers.ResourceAttributes{
+			RequiredAttributes: []string{"value"},
+			OptionalAttributes: []string{"effect"},
+		},
+		ValueFormat:  helpers.ValueFormatInteger,
+		MonitorTypes: loadTimeMonitorTypes,
+	},
+	"LoadTimeLimit2": {
+		ResourceAttributes: helpers.ResourceAttributes{
+			RequiredAttributes: []string{"value"},
+			OptionalAttributes: []string{"effect"},
+		},
+		ValueFormat:  helpers.ValueFormatInteger,
+		MonitorTypes: loadTimeMonitorTypes,
+	},
+	"TotalMinBytes": {
+		ResourceAttributes: helpers.ResourceAttributes{
+			RequiredAttributes: []string{"value"},
+			OptionalAttributes: []string{},
+		},
+		ValueFormat:  helpers.ValueFormatInteger,
+		MonitorTypes: append(append([]string{}, httpMonitorTypes...), browserMonitorTypes...),
+	},
+	"TotalMaxBytes": {
+		ResourceAttributes: helpers.ResourceAttributes{
+			RequiredAttributes: []string{"value"},
+			OptionalAttributes: []string{},
+		},
+		ValueFormat:  helpers.ValueFormatInteger,
+		MonitorTypes: append(append([]string{}, httpMonitorTypes...), browserMonitorTypes...),
+	},
+	"ContentMatch": {
+		ResourceAttributes: helpers.ResourceAttributes{
+			RequiredAttributes: []string{"value"},
+			OptionalAttributes: []string{"match_type"},
+		},
+		ValueFormat:  helpers.ValueFormatText,
+		MonitorTypes: append([]string{"FullPageCheck"}, httpMonitorTypes...),
+	},
+	"HttpStatus": {
+		ResourceAttributes: helpers.ResourceAttributes{
+			RequiredAttributes: []string{"value"},
+			OptionalAttributes: []string{},
+		},
+		ValueFormat:  helpers.ValueFormatInteger,
+		MonitorTypes: httpMonitorTypes,
+	},
+	"ConsoleContentMatch": {
+		ResourceAttributes: helpers.ResourceAttributes{
+			RequiredAttributes: []string{"value"},
+			OptionalAttributes: []string{"match_type"},
+		},
+		ValueFormat:  helpers.ValueFormatText,
+		MonitorTypes: browserMonitorTypes,
+	},
+	"ConsoleLevel": {
+		ResourceAttributes: helpers.ResourceAttributes{
+			RequiredAttributes: []string{"level"},
+			OptionalAttributes: []string{},
+		},
+		MonitorTypes: browserMonitorTypes,
+	},
+	"PageElementMaxSizeWithPercentage": {
+		ResourceAttributes: helpers.ResourceAttributes{
+			RequiredAttributes: []string{"value", "percentage"},
+			OptionalAttributes: []string{},
+		},
+		ValueFormat:  helpers.ValueFormatInteger,
+		MonitorTypes: browserMonitorTypes,
+	},
+	"PageElementFailedWithPercentage": {
+		ResourceAttributes: helpers.ResourceAttributes{
+			RequiredAttributes: []string{"percentage"},
+			OptionalAttributes: []string{"value"},
+		},
+		ValueFormat:  helpers.ValueFormatInteger,
+		MonitorTypes: browserMonitorTypes,
+	},
+	"PageElementUrlMatch": {
+		ResourceAttributes: helpers.ResourceAttributes{
+			RequiredAttributes: []string{"value"},
+			OptionalAttributes: []string{"match_type"},
+		},
+		ValueFormat:  helpers.ValueFormatText,
+		MonitorTypes: browserMonitorTypes,
+	},
+	"TimeToFirstByteMaximum":        browserMetricRule,
+	"RequestStartMaximum":           browserMetricRule,
+	"DomCompleteMaximum":            browserMetricRule,
+	"DomInteractiveMaximum":         browserMetricRule,
+	"FirstContentfulPaintMaximum":   browserMetricRule,
+	"LargestContentfulPaintMaximum": browserMetricRule,
+	"TimeToInteractiveMaximum":      browserMetricRule,
+	"TotalBlockingTimeMaximum":      browserMetricRule,
+	"CumulativeLayoutShiftMaximum": {
+		ResourceAttributes: helpers.ResourceAttributes{
+			RequiredAttributes: []string{"value"},
+			OptionalAttributes: []string{"effect"},
+		},
+		ValueFormat:  helpers.ValueFormatDecimal,
+		MonitorTypes: browserMonitorTypes,
+	},
+	"UseRecommendedCoreWebVitals": {
+		ResourceAttributes: helpers.ResourceAttributes{
+			RequiredAttributes: []string{"value"},
+			OptionalAttributes: []string{"effect"},
+		},
+		ValueFormat:  helpers.ValueFormatBoolean,
+		MonitorTypes: browserMonitorTypes,
+	},
+}
@@ -0,0 +1,66 @@
+package constants
+
+import "github.com/itrs-group/terraform-provider-itrs-uptrends/helpers"
+
+var allIntegrationAttributes = []string{
+	"name", // Required within the schema
+	"type", // Required within the schema
+	"variables",
+}
+
+// IntegrationTypes lists the integration types that can be managed with itrs-uptrends_integration.
+var IntegrationTypes = []string{
+	"GenericWebhook",
+	"Slack",
+	"MicrosoftTeams",
+	"PagerDuty",
+	"Statushub",
+}
+
+// IntegrationResourceAttributes defines the required and optional attributes for each integration type.
+// Secrets are optional here, because they are write-only and only required when the integration is created.
+var IntegrationResourceAttributes = map[string]helpers.ResourceAttributes{
+	"GenericWebhook": {
+		RequiredAttributes: []string{"url"},
+		OptionalAttributes: append([]string{"http_method", "body_template"}, allIntegrationAttributes...),
+	},
+	"Slack": {
+		RequiredAttributes: []string{},
+		OptionalAttributes: append([]string{"webhook_url_wo", "webhook_url_wo_version"}, allIntegrationAttributes...),
+	},
+	"MicrosoftTeams": {
+		RequiredAttributes: []string{},
+		OptionalAttributes: append([]string{"webhook_url_wo", "webhook_url_wo_version"}, allIntegrationAttributes...),
+	},
+	"PagerDuty": {
+		RequiredAttributes: []string{},
+		OptionalAttributes: append([]string{"integration_key_wo", "integration_key_wo_version"}, allIntegrationAttributes...),
+	},
+	"Statushub": {
+		RequiredAttributes: []string{"hub_name"},
+		OptionalAttributes: append([]string{"api_key_wo", "api_key_wo_version"}, allIntegrationAttributes...),
+	},
+}
+
+var IntegrationResourceAttributesCreate = map[string]helpers.ResourceAttributes{
+	"GenericWebhook": {
+		RequiredAttributes: []string{"url"},
+		OptionalAttributes: append([]string{"http_method", "body_template"}, allIntegrationAttributes...),
+	},
+	"Slack": {
+		RequiredAttributes: []string{"webhook_url_wo"},
+		OptionalAttributes: append([]string{"webhook_url_wo_version"}, allIntegrationAttributes...),
+	},
+	"MicrosoftTeams": {
+		RequiredAttributes: []string{"webhook_url_wo"},
+		OptionalAttributes: append([]string{"webhook_url_wo_version"}, allIntegrationAttributes...),
+	},
+	"PagerDuty": {
+		RequiredAttributes: []string{"integration_key_wo"},
+		OptionalAttributes: append([]string{"integration_key_wo_version"}, allIntegrationAttributes...),
+	},
+	"Statushub": {
+		RequiredAttributes: []string{"hub_name", "api_key_wo"},
+		OptionalAttributes: append([]string{"api_key_wo_version"}, allIntegrationAttributes...),
+	},
+}
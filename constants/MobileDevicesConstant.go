@@ -0,0 +1,23 @@
+package constants
+
+import "github.com/itrs-group/terraform-provider-itrs-uptrends/helpers"
+
+// MobileDevices is the catalog of devices that FullPageCheck and Transaction monitors can emulate
+// through browser_window_dimensions.mobile_device, with their native viewport dimensions.
+// Uptrends doesn't publish its device list, so the monitor resource only warns about devices
+// and dimensions that don't match this catalog.
+var MobileDevices = []helpers.MobileDevice{
+	{Name: "iPhone SE", Width: 375, Height: 667, PixelRatio: 2},
+	{Name: "iPhone 8", Width: 375, Height: 667, PixelRatio: 2},
+	{Name: "iPhone 8 Plus", Width: 414, Height: 736, PixelRatio: 3},
+	{Name: "iPhone X", Width: 375, Height: 812, PixelRatio: 3},
+	{Name: "iPhone 11", Width: 414, Height: 896, PixelRatio: 2},
+	{Name: "iPhone 12", Width: 390, Height: 844, PixelRatio: 3},
+	{Name: "iPhone 13", Width: 390, Height: 844, PixelRatio: 3},
+	{Name: "iPhone 14", Width: 390, Height: 844, PixelRatio: 3},
+	{Name: "Galaxy S8", Width: 360, Height: 740, PixelRatio: 4},
+	{Name: "Galaxy S9", Width: 360, Height: 740, PixelRatio: 4},
+	{Name: "Galaxy S20", Width: 360, Height: 800, PixelRatio: 3},
+	{Name: "Pixel 2", Width: 411, Height: 731, PixelRatio: 3},
+	{Name: "Pixel 5", Width: 393, Height: 851, PixelRatio: 3},
+}
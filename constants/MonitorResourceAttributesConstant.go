@@ -19,20 +19,68 @@ var allMonitorAttributes = []string{
 	"selected_checkpoints",
 	"use_primary_checkpoints_only",
 	"initial_monitor_group_id_wo",
+	"monitorgroup_ids",
 }
 
 var MonitorResourceAttributes = map[string]helpers.ResourceAttributes{
 	"Http": {
-		RequiredAttributes: []string{},
-		OptionalAttributes: []string{},
+		RequiredAttributes: []string{
+			"url",
+		},
+		OptionalAttributes: append([]string{
+			"authentication_type",
+			"user_agent",
+			"ip_version",
+			"http_method",
+			"http_version",
+			"username",
+			"password_wo_version",
+			"password_wo",
+			"credential_vault_item_id",
+			"request_headers",
+			"request_body",
+			"error_conditions",
+		}, allMonitorAttributes...),
 	},
 	"WebserviceHttp": {
-		RequiredAttributes: []string{},
-		OptionalAttributes: []string{},
+		RequiredAttributes: []string{
+			"url",
+		},
+		OptionalAttributes: append([]string{
+			"authentication_type",
+			"user_agent",
+			"ip_version",
+			"http_method",
+			"http_version",
+			"username",
+			"password_wo_version",
+			"password_wo",
+			"credential_vault_item_id",
+			"request_headers",
+			"request_body",
+			"error_conditions",
+		}, allMonitorAttributes...),
 	},
 	"WebserviceHttps": {
-		RequiredAttributes: []string{},
-		OptionalAttributes: []string{},
+		RequiredAttributes: []string{
+			"url",
+		},
+		OptionalAttributes: append([]string{
+			"authentication_type",
+			"user_agent",
+			"ip_version",
+			"http_method",
+			"http_version",
+			"tls_version",
+			"username",
+			"password_wo_version",
+			"password_wo",
+			"credential_vault_item_id",
+			"request_headers",
+			"request_body",
+			"check_certificate_errors",
+			"error_conditions",
+		}, allMonitorAttributes...),
 	},
 	"Https": {
 		RequiredAttributes: []string{
@@ -48,6 +96,7 @@ var MonitorResourceAttributes = map[string]helpers.ResourceAttributes{
 			"username",
 			"password_wo_version",
 			"password_wo",
+			"credential_vault_item_id",
 			"request_headers",
 			"request_body",
 			"check_certificate_errors",
@@ -75,6 +124,7 @@ var MonitorResourceAttributes = map[string]helpers.ResourceAttributes{
 			"username",
 			"password_wo_version",
 			"password_wo",
+			"credential_vault_item_id",
 			"request_headers",
 			"use_w3c_total_time",
 		}, allMonitorAttributes...),
@@ -97,6 +147,7 @@ var MonitorResourceAttributes = map[string]helpers.ResourceAttributes{
 			"username",
 			"password_wo_version",
 			"password_wo",
+			"credential_vault_item_id",
 			"request_headers",
 			"use_w3c_total_time",
 		}, allMonitorAttributes...),
@@ -146,6 +197,7 @@ var MonitorResourceAttributes = map[string]helpers.ResourceAttributes{
 			"username",
 			"password_wo_version",
 			"password_wo",
+			"credential_vault_item_id",
 		}, allMonitorAttributes...),
 	},
 	"SFTP": {
@@ -160,6 +212,7 @@ var MonitorResourceAttributes = map[string]helpers.ResourceAttributes{
 			"sftp_action_path",
 			"password_wo_version",
 			"password_wo",
+			"credential_vault_item_id",
 			"port",
 		}, allMonitorAttributes...),
 	},
@@ -173,6 +226,7 @@ var MonitorResourceAttributes = map[string]helpers.ResourceAttributes{
 			"username",
 			"password_wo_version",
 			"password_wo",
+			"credential_vault_item_id",
 			"port",
 		}, allMonitorAttributes...),
 	},
@@ -186,6 +240,7 @@ var MonitorResourceAttributes = map[string]helpers.ResourceAttributes{
 			"username",
 			"password_wo_version",
 			"password_wo",
+			"credential_vault_item_id",
 			"port",
 		}, allMonitorAttributes...),
 	},
@@ -194,11 +249,13 @@ var MonitorResourceAttributes = map[string]helpers.ResourceAttributes{
 			"network_address",
 		},
 		OptionalAttributes: append([]string{
+			"pop3_secure_connection",
 			"ip_version",
 			"error_conditions",
 			"username",
 			"password_wo_version",
 			"password_wo",
+			"credential_vault_item_id",
 			"port",
 		}, allMonitorAttributes...),
 	},
@@ -213,6 +270,7 @@ var MonitorResourceAttributes = map[string]helpers.ResourceAttributes{
 			"username",
 			"password_wo_version",
 			"password_wo",
+			"credential_vault_item_id",
 			"port",
 		}, allMonitorAttributes...),
 	},
@@ -225,6 +283,7 @@ var MonitorResourceAttributes = map[string]helpers.ResourceAttributes{
 			"username",
 			"password_wo_version",
 			"password_wo",
+			"credential_vault_item_id",
 			"error_conditions",
 			"port",
 			"database_name",
@@ -239,6 +298,7 @@ var MonitorResourceAttributes = map[string]helpers.ResourceAttributes{
 			"username",
 			"password_wo_version",
 			"password_wo",
+			"credential_vault_item_id",
 			"error_conditions",
 			"port",
 			"database_name",
@@ -0,0 +1,23 @@
+package constants
+
+// DefaultPresetPeriod is the period used by history data sources when no time range is configured.
+const DefaultPresetPeriod = "Last24Hours"
+
+// PresetPeriods are the named periods the history endpoints accept instead of a start and end time.
+var PresetPeriods = []string{
+	"Last2Hours",
+	"Last12Hours",
+	"Last24Hours",
+	"Last48Hours",
+	"Last7Days",
+	"Last30Days",
+	"Last90Days",
+	"Today",
+	"Yesterday",
+	"CurrentWeek",
+	"PreviousWeek",
+	"CurrentMonth",
+	"PreviousMonth",
+	"CurrentYear",
+	"PreviousYear",
+}
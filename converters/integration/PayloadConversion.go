@@ -0,0 +1,70 @@
+package converters
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	jsonmodels "github.com/itrs-group/terraform-provider-itrs-uptrends/client/models"
+	tfsdkmodels "github.com/itrs-group/terraform-provider-itrs-uptrends/provider/models"
+)
+
+// PayloadConversion builds the request to create or update an integration. Write-only secrets are taken from
+// config and only sent when they are set.
+func PayloadConversion(ctx context.Context, config tfsdkmodels.IntegrationResourceModel) (jsonmodels.IntegrationRequest, diag.Diagnostics) {
+	payload := jsonmodels.IntegrationRequest{
+		Name:      config.Name.ValueString(),
+		Type:      config.Type.ValueString(),
+		Variables: []jsonmodels.IntegrationVariable{},
+	}
+
+	// GenericWebhook specific fields
+	if !config.Url.IsNull() && !config.Url.IsUnknown() {
+		url := config.Url.ValueString()
+		payload.Url = &url
+	}
+	if !config.HttpMethod.IsNull() && !config.HttpMethod.IsUnknown() {
+		httpMethod := config.HttpMethod.ValueString()
+		payload.HttpMethod = &httpMethod
+	}
+	if !config.BodyTemplate.IsNull() && !config.BodyTemplate.IsUnknown() {
+		body := config.BodyTemplate.ValueString()
+		payload.CustomBody = &body
+	}
+
+	// Slack and MicrosoftTeams specific fields : the webhook URL is write only and we don't store it
+	if !config.WebhookUrl.IsNull() {
+		webhookUrl := config.WebhookUrl.ValueString()
+		payload.IncomingWebhookUrl = &webhookUrl
+	}
+
+	// PagerDuty specific fields : the integration key is write only and we don't store it
+	if !config.IntegrationKey.IsNull() {
+		integrationKey := config.IntegrationKey.ValueString()
+		payload.IntegrationKey = &integrationKey
+	}
+
+	// Statushub specific fields : the API key is write only and we don't store it
+	if !config.ApiKey.IsNull() {
+		apiKey := config.ApiKey.ValueString()
+		payload.ApiKey = &apiKey
+	}
+	if !config.HubName.IsNull() && !config.HubName.IsUnknown() {
+		hubName := config.HubName.ValueString()
+		payload.HubName = &hubName
+	}
+
+	var diags diag.Diagnostics
+	if !config.Variables.IsNull() && !config.Variables.IsUnknown() {
+		var variables []tfsdkmodels.IntegrationVariableModel
+		diags.Append(config.Variables.ElementsAs(ctx, &variables, false)...)
+		for _, v := range variables {
+			payload.Variables = append(payload.Variables, jsonmodels.IntegrationVariable{
+				Name:         v.Name.ValueString(),
+				DefaultValue: v.DefaultValue.ValueString(),
+				IsRequired:   v.Required.ValueBool(),
+			})
+		}
+	}
+
+	return payload, diags
+}
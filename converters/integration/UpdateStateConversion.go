@@ -0,0 +1,84 @@
+package converters
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	jsonmodels "github.com/itrs-group/terraform-provider-itrs-uptrends/client/models"
+	tfsdkmodels "github.com/itrs-group/terraform-provider-itrs-uptrends/provider/models"
+)
+
+// UpdateStateConversion maps an integration from the API to the resource model. Secrets and their versions are
+// left null; the caller copies the versions from the configuration or the prior state.
+func UpdateStateConversion(ctx context.Context, integration *jsonmodels.IntegrationResponse) (tfsdkmodels.IntegrationResourceModel, diag.Diagnostics) {
+	var state tfsdkmodels.IntegrationResourceModel
+	state.ID = types.StringValue(integration.IntegrationGuid)
+	state.Name = types.StringValue(integration.Name)
+	state.Type = types.StringValue(integration.Type)
+	state.Url = optionalString(integration.Url)
+	state.HttpMethod = optionalString(integration.HttpMethod)
+	state.BodyTemplate = optionalString(integration.CustomBody)
+	state.HubName = optionalString(integration.HubName)
+
+	variables, diags := variablesValue(ctx, integration.Variables)
+	state.Variables = variables
+	services, serviceDiags := servicesValue(ctx, integration.IntegrationServices)
+	diags.Append(serviceDiags...)
+	state.Services = services
+
+	return state, diags
+}
+
+// UpdateStateConversionDataSource maps an integration from the API to the data source model.
+func UpdateStateConversionDataSource(ctx context.Context, integration *jsonmodels.IntegrationResponse) (tfsdkmodels.IntegrationDataSourceModel, diag.Diagnostics) {
+	state, diags := UpdateStateConversion(ctx, integration)
+	return tfsdkmodels.IntegrationDataSourceModel{
+		ID:           state.ID,
+		Name:         state.Name,
+		Type:         state.Type,
+		Url:          state.Url,
+		HttpMethod:   state.HttpMethod,
+		BodyTemplate: state.BodyTemplate,
+		HubName:      state.HubName,
+		Variables:    state.Variables,
+		Services:     state.Services,
+	}, diags
+}
+
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// variablesValue returns the variables as a list, or a null list when the integration has no variables.
+func variablesValue(ctx context.Context, variables []jsonmodels.IntegrationVariable) (types.List, diag.Diagnostics) {
+	elementType := types.ObjectType{AttrTypes: tfsdkmodels.IntegrationVariableAttrTypes}
+	if len(variables) == 0 {
+		return types.ListNull(elementType), nil
+	}
+	models := make([]tfsdkmodels.IntegrationVariableModel, 0, len(variables))
+	for _, v := range variables {
+		models = append(models, tfsdkmodels.IntegrationVariableModel{
+			Name:         types.StringValue(v.Name),
+			DefaultValue: optionalString(v.DefaultValue),
+			Required:     types.BoolValue(v.IsRequired),
+		})
+	}
+	return types.ListValueFrom(ctx, elementType, models)
+}
+
+// servicesValue returns the Statushub services as a list, which is empty for other integration types.
+func servicesValue(ctx context.Context, services []jsonmodels.IntegrationService) (types.List, diag.Diagnostics) {
+	elementType := types.ObjectType{AttrTypes: tfsdkmodels.IntegrationServiceAttrTypes}
+	models := make([]tfsdkmodels.IntegrationServiceModel, 0, len(services))
+	for _, s := range services {
+		models = append(models, tfsdkmodels.IntegrationServiceModel{
+			ID:   types.StringValue(s.IntegrationServiceGuid),
+			Name: types.StringValue(s.Name),
+		})
+	}
+	return types.ListValueFrom(ctx, elementType, models)
+}
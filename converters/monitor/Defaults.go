@@ -0,0 +1,89 @@
+package converters
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	jsonmodels "github.com/itrs-group/terraform-provider-itrs-uptrends/client/models"
+	tfsdkmodels "github.com/itrs-group/terraform-provider-itrs-uptrends/provider/models"
+)
+
+// MonitorDefaults holds the provider-level values that are applied to every monitor.
+type MonitorDefaults struct {
+	CustomFields map[string]string
+	Notes        *string
+}
+
+// MergeCustomFields returns the resource custom fields followed by the default custom fields the resource doesn't set itself.
+// Resource-level values win over the defaults.
+func MergeCustomFields(fields *[]tfsdkmodels.CustomFieldModel, defaults MonitorDefaults) []tfsdkmodels.CustomFieldModel {
+	merged := []tfsdkmodels.CustomFieldModel{}
+	names := map[string]bool{}
+	if fields != nil {
+		for _, cf := range *fields {
+			merged = append(merged, cf)
+			names[cf.Name.ValueString()] = true
+		}
+	}
+
+	// Sort the default names so the request body is stable between runs.
+	defaultNames := make([]string, 0, len(defaults.CustomFields))
+	for name := range defaults.CustomFields {
+		defaultNames = append(defaultNames, name)
+	}
+	sort.Strings(defaultNames)
+
+	for _, name := range defaultNames {
+		if names[name] {
+			continue
+		}
+		merged = append(merged, tfsdkmodels.CustomFieldModel{
+			Name:  types.StringValue(name),
+			Value: types.StringValue(defaults.CustomFields[name]),
+		})
+	}
+	return merged
+}
+
+// StripDefaultCustomFields removes the custom fields that only exist because of the provider defaults,
+// so they don't show up in the resource's custom_fields. Fields listed in managed are always kept.
+func StripDefaultCustomFields(fields *[]tfsdkmodels.CustomFieldModel, defaults MonitorDefaults, managed *[]tfsdkmodels.CustomFieldModel) *[]tfsdkmodels.CustomFieldModel {
+	if fields == nil || len(defaults.CustomFields) == 0 {
+		return fields
+	}
+
+	managedNames := map[string]bool{}
+	if managed != nil {
+		for _, cf := range *managed {
+			managedNames[cf.Name.ValueString()] = true
+		}
+	}
+
+	stripped := []tfsdkmodels.CustomFieldModel{}
+	for _, cf := range *fields {
+		name := cf.Name.ValueString()
+		if _, isDefault := defaults.CustomFields[name]; isDefault && !managedNames[name] {
+			continue
+		}
+		stripped = append(stripped, cf)
+	}
+	return &stripped
+}
+
+func applyDefaults(payload *jsonmodels.MonitorRequest, config tfsdkmodels.MonitorModel, defaults MonitorDefaults) {
+	if len(defaults.CustomFields) > 0 {
+		var convCF []jsonmodels.CustomField
+		for _, cf := range MergeCustomFields(config.CustomFields, defaults) {
+			convCF = append(convCF, jsonmodels.CustomField{
+				Name:  cf.Name.ValueString(),
+				Value: cf.Value.ValueString(),
+			})
+		}
+		payload.CustomFields = convCF
+	}
+
+	if config.Notes.IsNull() && defaults.Notes != nil {
+		notes := *defaults.Notes
+		payload.Notes = &notes
+	}
+}
@@ -8,7 +8,8 @@ import (
 
 // PayloadConversion converts the tfsdk MonitorModel to the JSON MonitorRequest.
 // For optional fields (pointer types), the value is assigned only when not nil and not null.
-func PayloadConversion(config tfsdkmodels.MonitorModel) jsonmodels.MonitorRequest {
+// The provider defaults are merged in last, with the values of the resource taking precedence.
+func PayloadConversion(config tfsdkmodels.MonitorModel, defaults MonitorDefaults) jsonmodels.MonitorRequest {
 
 	payload := jsonmodels.MonitorRequest{
 		Name:          config.Name.ValueString(),
@@ -138,6 +139,13 @@ func PayloadConversion(config tfsdkmodels.MonitorModel) jsonmodels.MonitorReques
 		payload.Password = &v
 	}
 
+	if !config.CredentialVaultItemId.IsNull() {
+		username := VaultReference(config.CredentialVaultItemId.ValueString(), VaultFieldUsername)
+		password := VaultReference(config.CredentialVaultItemId.ValueString(), VaultFieldPassword)
+		payload.Username = &username
+		payload.Password = &password
+	}
+
 	if !config.NameForPhoneAlerts.IsNull() {
 		v := config.NameForPhoneAlerts.ValueString()
 		payload.NameForPhoneAlerts = &v
@@ -273,6 +281,11 @@ func PayloadConversion(config tfsdkmodels.MonitorModel) jsonmodels.MonitorReques
 		payload.ImapSecureConnection = &v
 	}
 
+	if !config.Pop3SecureConnection.IsNull() {
+		v := config.Pop3SecureConnection.ValueBool()
+		payload.Pop3SecureConnection = &v
+	}
+
 	if !config.SftpAction.IsNull() {
 		v := config.SftpAction.ValueString()
 		payload.SftpAction = &v
@@ -376,6 +389,8 @@ func PayloadConversion(config tfsdkmodels.MonitorModel) jsonmodels.MonitorReques
 		payload.ErrorConditions = &convEC
 	}
 
+	applyDefaults(&payload, config, defaults)
+
 	return payload
 }
 
@@ -69,6 +69,9 @@ func UpdateStateConversion(monitor *jsonmodels.MonitorResponse) tfsdkmodels.Moni
 	} else {
 		state.CustomFields = &[]tfsdkmodels.CustomFieldModel{}
 	}
+	// custom_fields_all always holds every field of the monitor, including the ones added by the provider defaults.
+	customFieldsAll := append([]tfsdkmodels.CustomFieldModel{}, *state.CustomFields...)
+	state.CustomFieldsAll = &customFieldsAll
 
 	state.SelectedCheckpoints = convertSelectedCheckpointsFromJSON(monitor.SelectedCheckpoints)
 
@@ -165,6 +168,15 @@ func UpdateStateConversion(monitor *jsonmodels.MonitorResponse) tfsdkmodels.Moni
 		state.Username = types.StringNull()
 	}
 
+	// A username that refers to a vault item is represented by credential_vault_item_id instead.
+	state.CredentialVaultItemId = types.StringNull()
+	if monitor.Username != nil {
+		if vaultItemGuid, _, ok := ParseVaultReference(*monitor.Username); ok {
+			state.CredentialVaultItemId = types.StringValue(vaultItemGuid)
+			state.Username = types.StringNull()
+		}
+	}
+
 	if monitor.Password != nil {
 		value := types.StringValue(*monitor.Password)
 		state.Password = value
@@ -359,6 +371,13 @@ func UpdateStateConversion(monitor *jsonmodels.MonitorResponse) tfsdkmodels.Moni
 		state.ImapSecureConnection = types.BoolNull()
 	}
 
+	if monitor.Pop3SecureConnection != nil {
+		value := types.BoolValue(*monitor.Pop3SecureConnection)
+		state.Pop3SecureConnection = value
+	} else {
+		state.Pop3SecureConnection = types.BoolNull()
+	}
+
 	if monitor.UseW3CTotalTime != nil {
 		value := types.BoolValue(*monitor.UseW3CTotalTime)
 		state.UseW3CTotalTime = value
@@ -486,6 +505,9 @@ func UpdateStateConversion(monitor *jsonmodels.MonitorResponse) tfsdkmodels.Moni
 		state.CreatedDate = types.StringNull()
 	}
 
+	// Memberships are not part of the monitor response; the resource fills these in separately.
+	state.MonitorGroupIds = types.SetNull(types.StringType)
+
 	return state
 }
 
@@ -738,6 +760,15 @@ func UpdateStateConversionDataSource(monitor *jsonmodels.MonitorResponse) tfsdkm
 		state.Username = types.StringNull()
 	}
 
+	// A username that refers to a vault item is represented by credential_vault_item_id instead.
+	state.CredentialVaultItemId = types.StringNull()
+	if monitor.Username != nil {
+		if vaultItemGuid, _, ok := ParseVaultReference(*monitor.Username); ok {
+			state.CredentialVaultItemId = types.StringValue(vaultItemGuid)
+			state.Username = types.StringNull()
+		}
+	}
+
 	if monitor.NameForPhoneAlerts != nil {
 		value := types.StringValue(*monitor.NameForPhoneAlerts)
 		state.NameForPhoneAlerts = value
@@ -925,6 +956,13 @@ func UpdateStateConversionDataSource(monitor *jsonmodels.MonitorResponse) tfsdkm
 		state.ImapSecureConnection = types.BoolNull()
 	}
 
+	if monitor.Pop3SecureConnection != nil {
+		value := types.BoolValue(*monitor.Pop3SecureConnection)
+		state.Pop3SecureConnection = value
+	} else {
+		state.Pop3SecureConnection = types.BoolNull()
+	}
+
 	if monitor.UseW3CTotalTime != nil {
 		value := types.BoolValue(*monitor.UseW3CTotalTime)
 		state.UseW3CTotalTime = value
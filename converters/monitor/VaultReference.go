@@ -0,0 +1,29 @@
+package converters
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Fields of a CredentialSet vault item that a monitor can reference.
+const (
+	VaultFieldUsername = "Username"
+	VaultFieldPassword = "Password"
+)
+
+var vaultReferencePattern = regexp.MustCompile(`^\{\{@VaultItem\.([0-9A-Fa-f-]+)\.(Username|Password)\}\}$`)
+
+// VaultReference returns the Uptrends placeholder that refers to a field of a vault item.
+func VaultReference(vaultItemGuid, field string) string {
+	return fmt.Sprintf("{{@VaultItem.%s.%s}}", vaultItemGuid, field)
+}
+
+// ParseVaultReference returns the vault item GUID and field of a vault placeholder.
+// ok is false when value is not a vault placeholder.
+func ParseVaultReference(value string) (vaultItemGuid string, field string, ok bool) {
+	match := vaultReferencePattern.FindStringSubmatch(value)
+	if match == nil {
+		return "", "", false
+	}
+	return match[1], match[2], true
+}
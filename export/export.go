@@ -0,0 +1,72 @@
+// Package export implements the export subcommand, which writes the configuration of an Uptrends account
+// as Terraform resources plus the import blocks to bring them under Terraform.
+package export
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+
+	"github.com/itrs-group/terraform-provider-itrs-uptrends/client"
+	api "github.com/itrs-group/terraform-provider-itrs-uptrends/client/api"
+	"github.com/itrs-group/terraform-provider-itrs-uptrends/constants"
+)
+
+// Run parses the arguments of the export subcommand and exports the account.
+func Run(args []string, stderr io.Writer) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	username := flags.String("username", os.Getenv("UPTRENDS_USERNAME"), "username of the API user, defaults to $UPTRENDS_USERNAME")
+	password := flags.String("password", os.Getenv("UPTRENDS_PASSWORD"), "password of the API user, defaults to $UPTRENDS_PASSWORD")
+	baseUrl := flags.String("baseurl", client.DefaultBaseUrl, "API URL")
+	out := flags.String("out", ".", "directory to write the .tf files to")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+	if *username == "" || *password == "" {
+		return errors.New("the API user credentials are required, set -username and -password or UPTRENDS_USERNAME and UPTRENDS_PASSWORD")
+	}
+
+	exporter := NewExporter(newClients(*baseUrl, *username, *password))
+	if err := exporter.Export(); err != nil {
+		return err
+	}
+	if err := exporter.WriteFiles(*out); err != nil {
+		return err
+	}
+	for _, warning := range exporter.Warnings {
+		fmt.Fprintf(stderr, "Warning: %s\n", warning)
+	}
+	return nil
+}
+
+func newClients(baseUrl, username, password string) Clients {
+	urlSource := client.NewUrlSource(baseUrl)
+	platform := runtime.GOOS
+	header := client.GenerateBasicAuthHeader(username, password)
+
+	return Clients{
+		Monitor:                            api.NewMonitorClient(header, urlSource.MonitorURL(), constants.NewBuildVersion, platform),
+		MonitorGroup:                       api.NewMonitorGroupClient(urlSource.MonitorGroupURL(), header, constants.NewBuildVersion, platform),
+		MonitorGroupMembership:             api.NewMonitorGroupMember(urlSource.MonitorGroupURL(), header, constants.NewBuildVersion, platform),
+		Operator:                           api.NewOperator(urlSource.OperatorURL(), header, constants.NewBuildVersion, platform),
+		OperatorGroup:                      api.NewOperatorGroup(urlSource.OperatorGroupURL(), header, constants.NewBuildVersion, platform),
+		OperatorGroupMembership:            api.NewMembership(urlSource.OperatorGroupURL(), header, constants.NewBuildVersion, platform),
+		AlertDefinition:                    api.NewAlertDefinition(urlSource.AlertDefinitionURL(), header, constants.NewBuildVersion, platform),
+		AlertDefinitionMonitorMember:       api.NewAlertDefinitionMonitorMember(urlSource.AlertDefinitionURL(), header, constants.NewBuildVersion, platform),
+		AlertDefinitionMonitorGroupMember:  api.NewAlertDefinitionMonitorGroupMembership(urlSource.AlertDefinitionURL(), header, constants.NewBuildVersion, platform),
+		AlertDefinitionOperatorMember:      api.NewAlertDefinitionOperatorMembership(urlSource.AlertDefinitionURL(), header, constants.NewBuildVersion, platform),
+		AlertDefinitionOperatorGroupMember: api.NewAlertDefinitionOperatorGroupMembership(urlSource.AlertDefinitionURL(), header, constants.NewBuildVersion, platform),
+		Integration:                        api.NewIntegration(urlSource.IntegrationURL(), header, constants.NewBuildVersion, platform),
+		EscalationLevelIntegration:         api.NewEscalationLevelIntegration(urlSource.AlertDefinitionURL(), header, constants.NewBuildVersion, platform),
+		VaultSection:                       api.NewVaultSection(urlSource.VaultSectionURL(), header, constants.NewBuildVersion, platform),
+		VaultItem:                          api.NewVaultItem(urlSource.VaultItemURL(), header, constants.NewBuildVersion, platform),
+		RumWebsite:                         api.NewRumWebsite(urlSource.RumWebsiteURL(), header, constants.NewBuildVersion, platform),
+	}
+}
@@ -0,0 +1,590 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	interfaces "github.com/itrs-group/terraform-provider-itrs-uptrends/client/interfaces"
+	models "github.com/itrs-group/terraform-provider-itrs-uptrends/client/models"
+	"github.com/itrs-group/terraform-provider-itrs-uptrends/constants"
+	integrationconverters "github.com/itrs-group/terraform-provider-itrs-uptrends/converters/integration"
+	converters "github.com/itrs-group/terraform-provider-itrs-uptrends/converters/monitor"
+	"github.com/itrs-group/terraform-provider-itrs-uptrends/helpers"
+	"github.com/samber/lo"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Output files, in the order in which they are written.
+const (
+	vaultFile            = "vault.tf"
+	monitorGroupsFile    = "monitorgroups.tf"
+	monitorsFile         = "monitors.tf"
+	operatorsFile        = "operators.tf"
+	integrationsFile     = "integrations.tf"
+	alertDefinitionsFile = "alertdefinitions.tf"
+	rumWebsitesFile      = "rum_websites.tf"
+	importsFile          = "imports.tf"
+)
+
+var outputFiles = []string{
+	vaultFile,
+	monitorGroupsFile,
+	monitorsFile,
+	operatorsFile,
+	integrationsFile,
+	alertDefinitionsFile,
+	rumWebsitesFile,
+	importsFile,
+}
+
+// Monitor attributes that are not exported: they are read-only, write-only or only used on create.
+var skippedMonitorAttributes = map[string]bool{
+	"id":                          true,
+	"created_date":                true,
+	"custom_fields_all":           true,
+	"monitorgroup_ids":            true,
+	"password_wo":                 true,
+	"password_wo_version":         true,
+	"initial_monitor_group_id_wo": true,
+	"credential_vault_item_id":    true, // written as a reference
+}
+
+// Integration attributes that are not exported: they are read-only.
+var skippedIntegrationAttributes = map[string]bool{
+	"id":       true,
+	"services": true,
+}
+
+// Clients holds the API clients used by the exporter.
+type Clients struct {
+	Monitor                            interfaces.IMonitor
+	MonitorGroup                       interfaces.IMonitorGroupClient
+	MonitorGroupMembership             interfaces.IMonitorGroupMember
+	Operator                           interfaces.IOperator
+	OperatorGroup                      interfaces.IOperatorGroup
+	OperatorGroupMembership            interfaces.IMembership
+	AlertDefinition                    interfaces.IAlertDefinition
+	AlertDefinitionMonitorMember       interfaces.IAlertDefinitionMonitorMember
+	AlertDefinitionMonitorGroupMember  interfaces.IAlertDefinitionMonitorGroupMember
+	AlertDefinitionOperatorMember      interfaces.IAlertDefinitionOperatorMembership
+	AlertDefinitionOperatorGroupMember interfaces.IAlertDefinitionOperatorGroupMembership
+	Integration                        interfaces.IIntegration
+	EscalationLevelIntegration         interfaces.IEscalationLevelIntegration
+	VaultSection                       interfaces.IVaultSection
+	VaultItem                          interfaces.IVaultItem
+	RumWebsite                         interfaces.IRumWebsite
+}
+
+// Exporter turns the objects of an Uptrends account into Terraform configuration and import blocks.
+type Exporter struct {
+	clients Clients
+
+	files     map[string]*hclwrite.File
+	addresses map[string]hcl.Traversal // object GUID to resource address
+	names     map[string]string        // object GUID to resource name
+	usedNames map[string]map[string]bool
+
+	// Warnings lists the parts of the account that could not be exported.
+	Warnings []string
+}
+
+// NewExporter creates an exporter that reads the account through the given clients.
+func NewExporter(clients Clients) *Exporter {
+	files := make(map[string]*hclwrite.File, len(outputFiles))
+	for _, name := range outputFiles {
+		files[name] = hclwrite.NewEmptyFile()
+	}
+	return &Exporter{
+		clients:   clients,
+		files:     files,
+		addresses: map[string]hcl.Traversal{},
+		names:     map[string]string{},
+		usedNames: map[string]map[string]bool{},
+	}
+}
+
+// Export reads the account. Objects are read in dependency order, so cross-references can be written as resource addresses.
+func (e *Exporter) Export() error {
+	steps := []func() error{
+		e.exportVault,
+		e.exportMonitorGroups,
+		e.exportMonitors,
+		e.exportMonitorGroupMemberships,
+		e.exportOperators,
+		e.exportOperatorGroups,
+		e.exportIntegrations,
+		e.exportAlertDefinitions,
+		e.exportRumWebsites,
+	}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteFiles writes the generated files to dir. Existing files are not overwritten.
+func (e *Exporter) WriteFiles(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for _, name := range outputFiles {
+		content := e.files[name].Bytes()
+		if len(content) == 0 {
+			continue
+		}
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err == nil {
+			return fmt.Errorf("%s already exists", path)
+		}
+		if err := os.WriteFile(path, hclwrite.Format(content), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addResource adds a resource block named after label to file, together with its import block, and returns the block body.
+// When guid is not empty, the resource address is registered for references to guid.
+func (e *Exporter) addResource(file, resourceType, label, guid, importID string) *hclwrite.Body {
+	if e.usedNames[resourceType] == nil {
+		e.usedNames[resourceType] = map[string]bool{}
+	}
+	name := helpers.TerraformResourceName(label, e.usedNames[resourceType])
+	address := addressTraversal(resourceType, name)
+	if guid != "" {
+		e.addresses[guid] = address
+		e.names[guid] = name
+	}
+
+	body := e.files[file].Body()
+	if len(body.Blocks()) > 0 {
+		body.AppendNewline()
+	}
+	block := body.AppendNewBlock("resource", []string{resourceType, name}).Body()
+
+	imports := e.files[importsFile].Body()
+	if len(imports.Blocks()) > 0 {
+		imports.AppendNewline()
+	}
+	importBlock := imports.AppendNewBlock("import", nil).Body()
+	importBlock.SetAttributeTraversal("to", address)
+	importBlock.SetAttributeValue("id", cty.StringVal(importID))
+
+	return block
+}
+
+// membershipLabel names a membership resource after the objects it connects.
+func (e *Exporter) membershipLabel(guids ...string) string {
+	return strings.Join(lo.Map(guids, func(guid string, _ int) string {
+		if name, ok := e.names[guid]; ok {
+			return name
+		}
+		return guid
+	}), "_")
+}
+
+func listError(what string, statusCode int, responseBody string, err error) error {
+	if err != nil {
+		return fmt.Errorf("error listing %s: %w", what, err)
+	}
+	if statusCode >= 300 {
+		return fmt.Errorf("failed to list %s: HTTP status code: %d with response body %v", what, statusCode, responseBody)
+	}
+	return nil
+}
+
+func (e *Exporter) exportVault() error {
+	sections, statusCode, responseBody, err := e.clients.VaultSection.GetVaultSections()
+	if err := listError("vault sections", statusCode, responseBody, err); err != nil {
+		return err
+	}
+	sort.Slice(sections, func(i, j int) bool {
+		return sortKey(sections[i].Name, sections[i].VaultSectionGuid) < sortKey(sections[j].Name, sections[j].VaultSectionGuid)
+	})
+	for _, section := range sections {
+		body := e.addResource(vaultFile, "itrs-uptrends_vault_section", section.Name, section.VaultSectionGuid, section.VaultSectionGuid)
+		setString(body, "name", section.Name)
+	}
+
+	items, statusCode, responseBody, err := e.clients.VaultItem.GetVaultItems()
+	if err := listError("vault items", statusCode, responseBody, err); err != nil {
+		return err
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return sortKey(items[i].Name, items[i].VaultItemGuid) < sortKey(items[j].Name, items[j].VaultItemGuid)
+	})
+	for _, item := range items {
+		body := e.addResource(vaultFile, "itrs-uptrends_vault_item", item.Name, item.VaultItemGuid, item.VaultItemGuid)
+		setString(body, "name", item.Name)
+		e.setReference(body, "vault_section_id", item.VaultSectionGuid)
+		setString(body, "vault_item_type", item.VaultItemType)
+		setString(body, "notes", item.Notes)
+		if item.UserName != nil {
+			setString(body, "username", *item.UserName)
+		}
+	}
+	if len(items) > 0 {
+		e.Warnings = append(e.Warnings, "Secrets of vault items are not exported. Add them to the exported vault items before you replace them.")
+	}
+	return nil
+}
+
+func (e *Exporter) exportMonitorGroups() error {
+	groups, statusCode, responseBody, err := e.clients.MonitorGroup.GetMonitorGroups()
+	if err := listError("monitor groups", statusCode, responseBody, err); err != nil {
+		return err
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return sortKey(groups[i].Description, groups[i].MonitorGroupGuid) < sortKey(groups[j].Description, groups[j].MonitorGroupGuid)
+	})
+	for _, group := range groups {
+		// The All monitors group is built in.
+		if group.IsAll {
+			continue
+		}
+		body := e.addResource(monitorGroupsFile, "itrs-uptrends_monitorgroup", group.Description, group.MonitorGroupGuid, group.MonitorGroupGuid)
+		setString(body, "description", group.Description)
+		if group.IsQuotaUnlimited == nil {
+			continue
+		}
+		body.SetAttributeValue("is_quota_unlimited", cty.BoolVal(*group.IsQuotaUnlimited))
+		if *group.IsQuotaUnlimited {
+			continue
+		}
+		setOptionalInt(body, "basic_monitor_quota", group.BasicMonitorQuota)
+		setOptionalInt(body, "browser_monitor_quota", group.BrowserMonitorQuota)
+		setOptionalInt(body, "transaction_monitor_quota", group.TransactionMonitorQuota)
+		setOptionalInt(body, "api_monitor_quota", group.ApiMonitorQuota)
+		setOptionalInt(body, "unified_credits_quota", group.UnifiedCreditsQuota)
+		setOptionalInt(body, "classic_quota", group.ClassicQuota)
+	}
+	return nil
+}
+
+func (e *Exporter) exportMonitors() error {
+	monitors, statusCode, responseBody, err := e.clients.Monitor.GetMonitors()
+	if err := listError("monitors", statusCode, responseBody, err); err != nil {
+		return err
+	}
+	sort.Slice(monitors, func(i, j int) bool {
+		return sortKey(monitors[i].Name, monitors[i].MonitorGuid) < sortKey(monitors[j].Name, monitors[j].MonitorGuid)
+	})
+	for i := range monitors {
+		monitor := &monitors[i]
+		rules, ok := constants.MonitorResourceAttributes[monitor.MonitorType]
+		if !ok {
+			e.Warnings = append(e.Warnings, fmt.Sprintf("Monitor %q (%s) is not exported, monitor type %s is not supported.", monitor.Name, monitor.MonitorGuid, monitor.MonitorType))
+			continue
+		}
+		allowed := lo.SliceToMap(append(append([]string{}, rules.RequiredAttributes...), rules.OptionalAttributes...), func(name string) (string, bool) {
+			return name, true
+		})
+
+		model := converters.UpdateStateConversion(monitor)
+		body := e.addResource(monitorsFile, "itrs-uptrends_monitor", monitor.Name, monitor.MonitorGuid, monitor.MonitorGuid)
+		writeModel(body, model, func(name string) bool {
+			return allowed[name] && !skippedMonitorAttributes[name]
+		})
+		if !model.CredentialVaultItemId.IsNull() {
+			e.setReference(body, "credential_vault_item_id", model.CredentialVaultItemId.ValueString())
+		}
+	}
+	return nil
+}
+
+func (e *Exporter) exportMonitorGroupMemberships() error {
+	groups, statusCode, responseBody, err := e.clients.MonitorGroup.GetMonitorGroups()
+	if err := listError("monitor groups", statusCode, responseBody, err); err != nil {
+		return err
+	}
+	for _, group := range groups {
+		if _, ok := e.addresses[group.MonitorGroupGuid]; !ok {
+			continue
+		}
+		memberships, err := e.clients.MonitorGroupMembership.GetGroupMemberships(group.MonitorGroupGuid)
+		if err != nil {
+			return fmt.Errorf("error reading memberships of monitor group %s: %w", group.MonitorGroupGuid, err)
+		}
+		for _, membership := range memberships {
+			body := e.addResource(monitorGroupsFile, "itrs-uptrends_monitorgroup_membership",
+				e.membershipLabel(membership.MonitorGuid, group.MonitorGroupGuid), "",
+				membership.MonitorGuid+":"+group.MonitorGroupGuid)
+			e.setReference(body, "monitor_id", membership.MonitorGuid)
+			e.setReference(body, "monitorgroup_id", group.MonitorGroupGuid)
+		}
+	}
+	return nil
+}
+
+func (e *Exporter) exportOperators() error {
+	operators, statusCode, responseBody, err := e.clients.Operator.GetOperators()
+	if err := listError("operators", statusCode, responseBody, err); err != nil {
+		return err
+	}
+	sort.Slice(operators, func(i, j int) bool {
+		return sortKey(operators[i].FullName, operators[i].OperatorGuid) < sortKey(operators[j].FullName, operators[j].OperatorGuid)
+	})
+	for _, operator := range operators {
+		body := e.addResource(operatorsFile, "itrs-uptrends_operator", operator.FullName, operator.OperatorGuid, operator.OperatorGuid)
+		setString(body, "full_name", operator.FullName)
+		setString(body, "email", operator.Email)
+		body.SetAttributeValue("mobile_phone", cty.StringVal(operator.MobilePhone))
+		setString(body, "backup_email", operator.BackupEmail)
+		body.SetAttributeValue("is_on_duty", cty.BoolVal(operator.IsOnDuty))
+		body.SetAttributeValue("default_dashboard", cty.StringVal(operator.DefaultDashboard))
+		setString(body, "sms_provider", operator.SmsProvider)
+		setString(body, "operator_role", operator.OperatorRole)
+		body.SetAttributeValue("time_zone_id", cty.NumberIntVal(int64(operator.TimeZoneId)))
+		if operator.OutgoingPhoneNumberId != 0 {
+			body.SetAttributeValue("outgoing_phone_number_id", cty.NumberIntVal(int64(operator.OutgoingPhoneNumberId)))
+		}
+	}
+	return nil
+}
+
+func (e *Exporter) exportOperatorGroups() error {
+	groups, statusCode, responseBody, err := e.clients.OperatorGroup.GetOperatorGroups()
+	if err := listError("operator groups", statusCode, responseBody, err); err != nil {
+		return err
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return sortKey(groups[i].Description, groups[i].OperatorGroupGuid) < sortKey(groups[j].Description, groups[j].OperatorGroupGuid)
+	})
+	for _, group := range groups {
+		// The Everyone and Administrators groups are built in.
+		if group.IsEveryone || group.IsAdministratorsGroup {
+			continue
+		}
+		body := e.addResource(operatorsFile, "itrs-uptrends_operatorgroup", group.Description, group.OperatorGroupGuid, group.OperatorGroupGuid)
+		setString(body, "description", group.Description)
+	}
+
+	for _, group := range groups {
+		if group.IsEveryone || group.IsAdministratorsGroup {
+			continue
+		}
+		memberships, err := e.clients.OperatorGroupMembership.GetMemberships(group.OperatorGroupGuid)
+		if err != nil {
+			return fmt.Errorf("error reading memberships of operator group %s: %w", group.OperatorGroupGuid, err)
+		}
+		for _, membership := range memberships {
+			body := e.addResource(operatorsFile, "itrs-uptrends_operatorgroup_membership",
+				e.membershipLabel(membership.OperatorGuid, group.OperatorGroupGuid), "",
+				membership.OperatorGuid+":"+group.OperatorGroupGuid)
+			e.setReference(body, "operator_id", membership.OperatorGuid)
+			e.setReference(body, "operatorgroup_id", group.OperatorGroupGuid)
+		}
+	}
+	return nil
+}
+
+func (e *Exporter) exportIntegrations() error {
+	integrations, statusCode, responseBody, err := e.clients.Integration.GetIntegrations()
+	if err := listError("integrations", statusCode, responseBody, err); err != nil {
+		return err
+	}
+	sort.Slice(integrations, func(i, j int) bool {
+		return sortKey(integrations[i].Name, integrations[i].IntegrationGuid) < sortKey(integrations[j].Name, integrations[j].IntegrationGuid)
+	})
+	withSecrets, withWebhookUrls := false, false
+	for i := range integrations {
+		integration := &integrations[i]
+		// Built-in integrations, such as email and SMS, can't be managed with itrs-uptrends_integration.
+		if !lo.Contains(constants.IntegrationTypes, integration.Type) {
+			continue
+		}
+		model, diags := integrationconverters.UpdateStateConversion(context.Background(), integration)
+		if diags.HasError() {
+			return fmt.Errorf("error converting integration %s: %s", integration.IntegrationGuid, diags.Errors()[0].Detail())
+		}
+		body := e.addResource(integrationsFile, "itrs-uptrends_integration", integration.Name, integration.IntegrationGuid, integration.IntegrationGuid)
+		writeModel(body, model, func(name string) bool {
+			return !skippedIntegrationAttributes[name]
+		})
+		withSecrets = withSecrets || integration.Type != "GenericWebhook"
+		withWebhookUrls = withWebhookUrls || integration.Type == "GenericWebhook"
+	}
+	if withSecrets {
+		e.Warnings = append(e.Warnings, "Webhook URLs, integration keys and API keys of integrations are not exported. Add them to the exported integrations before you replace them.")
+	}
+	if withWebhookUrls {
+		e.Warnings = append(e.Warnings, "The url of generic webhook integrations is written to "+integrationsFile+" as returned by the API. Move it to a sensitive variable if it contains a token.")
+	}
+	return nil
+}
+
+func (e *Exporter) exportAlertDefinitions() error {
+	definitions, statusCode, responseBody, err := e.clients.AlertDefinition.GetAlertDefinitions()
+	if err := listError("alert definitions", statusCode, responseBody, err); err != nil {
+		return err
+	}
+	sort.Slice(definitions, func(i, j int) bool {
+		return sortKey(definitions[i].AlertName, definitions[i].AlertDefinitionGuid) < sortKey(definitions[j].AlertName, definitions[j].AlertDefinitionGuid)
+	})
+	for _, definition := range definitions {
+		levels, _, err := e.clients.AlertDefinition.GetEscalationLevels(definition.AlertDefinitionGuid)
+		if err != nil {
+			return fmt.Errorf("error reading escalation levels of alert definition %s: %w", definition.AlertDefinitionGuid, err)
+		}
+		sort.Slice(levels, func(i, j int) bool { return levels[i].Id < levels[j].Id })
+
+		body := e.addResource(alertDefinitionsFile, "itrs-uptrends_alertdefinition", definition.AlertName, definition.AlertDefinitionGuid, definition.AlertDefinitionGuid)
+		setString(body, "name", definition.AlertName)
+		body.SetAttributeValue("is_active", cty.BoolVal(definition.IsActive))
+		if len(levels) > 0 {
+			body.SetAttributeValue("escalation_levels", cty.TupleVal(lo.Map(levels, func(level models.EscalationLevel, _ int) cty.Value {
+				return cty.ObjectVal(map[string]cty.Value{
+					"id":                    cty.NumberIntVal(int64(level.Id)),
+					"escalation_mode":       cty.StringVal(level.EscalationMode),
+					"threshold_error_count": cty.NumberIntVal(int64(level.ThresholdErrorCount)),
+					"threshold_minutes":     cty.NumberIntVal(int64(level.ThresholdMinutes)),
+					"is_active":             cty.BoolVal(level.IsActive),
+					"message":               cty.StringVal(level.Message),
+					"number_of_reminders":   cty.NumberIntVal(int64(level.NumberOfReminders)),
+					"reminder_delay":        cty.NumberIntVal(int64(level.ReminderDelay)),
+					"include_trace_route":   cty.BoolVal(level.IncludeTraceRoute),
+				})
+			})))
+		}
+
+		if err := e.exportAlertDefinitionMemberships(definition.AlertDefinitionGuid, levels); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *Exporter) exportAlertDefinitionMemberships(alertDefinitionGuid string, levels []models.EscalationLevel) error {
+	assignments, err := e.clients.AlertDefinitionMonitorMember.GetAssignments(alertDefinitionGuid)
+	if err != nil {
+		return fmt.Errorf("error reading monitors of alert definition %s: %w", alertDefinitionGuid, err)
+	}
+	for _, assignment := range assignments {
+		if assignment.MonitorGuid == nil {
+			continue
+		}
+		body := e.addResource(alertDefinitionsFile, "itrs-uptrends_alertdefinition_monitor_membership",
+			e.membershipLabel(alertDefinitionGuid, *assignment.MonitorGuid), "",
+			alertDefinitionGuid+":"+*assignment.MonitorGuid)
+		e.setReference(body, "alertdefinition_id", alertDefinitionGuid)
+		e.setReference(body, "monitor_id", *assignment.MonitorGuid)
+	}
+
+	groupAssignments, err := e.clients.AlertDefinitionMonitorGroupMember.GetMonitorGroupAssignments(alertDefinitionGuid)
+	if err != nil {
+		return fmt.Errorf("error reading monitor groups of alert definition %s: %w", alertDefinitionGuid, err)
+	}
+	for _, assignment := range groupAssignments {
+		if assignment.MonitorGroupGuid == nil {
+			continue
+		}
+		body := e.addResource(alertDefinitionsFile, "itrs-uptrends_alertdefinition_monitorgroup_membership",
+			e.membershipLabel(alertDefinitionGuid, *assignment.MonitorGroupGuid), "",
+			alertDefinitionGuid+":"+*assignment.MonitorGroupGuid)
+		e.setReference(body, "alertdefinition_id", alertDefinitionGuid)
+		e.setReference(body, "monitorgroup_id", *assignment.MonitorGroupGuid)
+	}
+
+	for _, level := range levels {
+		operators, err := e.clients.AlertDefinitionOperatorMember.GetMembership(alertDefinitionGuid, level.Id)
+		if err != nil {
+			return fmt.Errorf("error reading operators of escalation level %d of alert definition %s: %w", level.Id, alertDefinitionGuid, err)
+		}
+		for _, member := range operators {
+			if member.OperatorGuid == "" {
+				continue
+			}
+			body := e.addResource(alertDefinitionsFile, "itrs-uptrends_alertdefinition_operator_membership",
+				fmt.Sprintf("%s_%d", e.membershipLabel(alertDefinitionGuid, member.OperatorGuid), level.Id), "",
+				fmt.Sprintf("%s:%s:%d", alertDefinitionGuid, member.OperatorGuid, level.Id))
+			e.setReference(body, "alertdefinition_id", alertDefinitionGuid)
+			e.setReference(body, "operator_id", member.OperatorGuid)
+			body.SetAttributeValue("escalationlevel", cty.NumberIntVal(int64(level.Id)))
+		}
+
+		operatorGroups, err := e.clients.AlertDefinitionOperatorGroupMember.GetMembership(alertDefinitionGuid, level.Id)
+		if err != nil {
+			return fmt.Errorf("error reading operator groups of escalation level %d of alert definition %s: %w", level.Id, alertDefinitionGuid, err)
+		}
+		for _, member := range operatorGroups {
+			if member.OperatorGroupGuid == "" {
+				continue
+			}
+			body := e.addResource(alertDefinitionsFile, "itrs-uptrends_alertdefinition_operatorgroup_membership",
+				fmt.Sprintf("%s_%d", e.membershipLabel(alertDefinitionGuid, member.OperatorGroupGuid), level.Id), "",
+				fmt.Sprintf("%s:%s:%d", alertDefinitionGuid, member.OperatorGroupGuid, level.Id))
+			e.setReference(body, "alertdefinition_id", alertDefinitionGuid)
+			e.setReference(body, "operatorgroup_id", member.OperatorGroupGuid)
+			body.SetAttributeValue("escalationlevel", cty.NumberIntVal(int64(level.Id)))
+		}
+
+		integrations, err := e.clients.EscalationLevelIntegration.GetIntegrations(alertDefinitionGuid, level.Id)
+		if err != nil {
+			return fmt.Errorf("error reading integrations of escalation level %d of alert definition %s: %w", level.Id, alertDefinitionGuid, err)
+		}
+		for _, integration := range integrations {
+			body := e.addResource(alertDefinitionsFile, "itrs-uptrends_escalation_level_integration",
+				fmt.Sprintf("%s_%d", e.membershipLabel(alertDefinitionGuid, integration.IntegrationGuid), level.Id), "",
+				fmt.Sprintf("%s:%d:%s", alertDefinitionGuid, level.Id, integration.IntegrationGuid))
+			e.setReference(body, "alertdefinition_id", alertDefinitionGuid)
+			body.SetAttributeValue("escalation_level_id", cty.NumberIntVal(int64(level.Id)))
+			e.setReference(body, "integration_guid", integration.IntegrationGuid)
+			if len(integration.VariableValues) > 0 {
+				body.SetAttributeValue("variable_values", cty.MapVal(lo.MapValues(integration.VariableValues, func(value, _ string) cty.Value {
+					return cty.StringVal(value)
+				})))
+			}
+			if integration.ExtraEmailAddresses != "" {
+				body.SetAttributeValue("extra_email_addresses", cty.TupleVal(lo.Map(strings.Split(integration.ExtraEmailAddresses, ","), func(address string, _ int) cty.Value {
+					return cty.StringVal(strings.TrimSpace(address))
+				})))
+			}
+			if len(integration.StatusHubServiceList) > 0 {
+				body.SetAttributeValue("status_hub_service_list", cty.TupleVal(lo.Map(integration.StatusHubServiceList, func(entry models.StatusHubServiceEntry, _ int) cty.Value {
+					return cty.ObjectVal(map[string]cty.Value{
+						"monitor_guid":             cty.StringVal(entry.MonitorGuid),
+						"integration_service_guid": cty.StringVal(entry.IntegrationServiceGuid),
+					})
+				})))
+			}
+		}
+	}
+	return nil
+}
+
+func (e *Exporter) exportRumWebsites() error {
+	websites, statusCode, responseBody, err := e.clients.RumWebsite.GetRumWebsites()
+	if err := listError("RUM websites", statusCode, responseBody, err); err != nil {
+		return err
+	}
+	sort.Slice(websites, func(i, j int) bool {
+		return sortKey(websites[i].Description, websites[i].RumWebsiteGuid) < sortKey(websites[j].Description, websites[j].RumWebsiteGuid)
+	})
+	for _, website := range websites {
+		body := e.addResource(rumWebsitesFile, "itrs-uptrends_rum_website", website.Description, website.RumWebsiteGuid, website.RumWebsiteGuid)
+		setString(body, "description", website.Description)
+		setString(body, "url", website.Url)
+		body.SetAttributeValue("is_spa", cty.BoolVal(website.IsSpa))
+		body.SetAttributeValue("include_url_fragment", cty.BoolVal(website.IncludeUrlFragment))
+	}
+	return nil
+}
+
+func setOptionalInt(body *hclwrite.Body, name string, value *int) {
+	if value != nil {
+		body.SetAttributeValue(name, cty.NumberIntVal(int64(*value)))
+	}
+}
+
+// sortKey orders objects by name, then GUID, so the generated files are stable.
+func sortKey(name, guid string) string {
+	return strings.ToLower(name) + "\x00" + guid
+}
@@ -0,0 +1,188 @@
+package export
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// addressTraversal returns the traversal for a resource address, e.g. itrs-uptrends_monitor.web_shop.
+func addressTraversal(resourceType, name string) hcl.Traversal {
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: name},
+	}
+}
+
+// setReference sets attribute name to the id of the resource exported for guid.
+// GUIDs of objects that are not exported, such as the built-in groups, are written as literal strings.
+func (e *Exporter) setReference(body *hclwrite.Body, name, guid string) {
+	address, ok := e.addresses[guid]
+	if !ok {
+		body.SetAttributeValue(name, cty.StringVal(guid))
+		return
+	}
+	body.SetAttributeTraversal(name, append(address, hcl.TraverseAttr{Name: "id"}))
+}
+
+// setString sets a string attribute, using a heredoc for multi-line values. Empty strings are left out.
+func setString(body *hclwrite.Body, name, value string) {
+	if value == "" {
+		return
+	}
+	if !strings.Contains(value, "\n") || containsLine(value, "EOT") {
+		body.SetAttributeValue(name, cty.StringVal(value))
+		return
+	}
+
+	// Escape template sequences, just like hclwrite does for quoted strings.
+	escaped := strings.NewReplacer("${", "$${", "%{", "%%{").Replace(value)
+	if !strings.HasSuffix(escaped, "\n") {
+		escaped += "\n"
+	}
+	body.SetAttributeRaw(name, hclwrite.Tokens{
+		{Type: hclsyntax.TokenOHeredoc, Bytes: []byte("<<EOT\n")},
+		{Type: hclsyntax.TokenStringLit, Bytes: []byte(escaped)},
+		{Type: hclsyntax.TokenCHeredoc, Bytes: []byte("EOT")},
+	})
+}
+
+func containsLine(value, line string) bool {
+	for _, l := range strings.Split(value, "\n") {
+		if strings.TrimSpace(l) == line {
+			return true
+		}
+	}
+	return false
+}
+
+// writeModel writes the tfsdk tagged fields of model to body, in field order.
+// Null values and empty strings and collections are left out, as are the attributes for which include returns false.
+func writeModel(body *hclwrite.Body, model any, include func(name string) bool) {
+	rv := reflect.ValueOf(model)
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		name := rt.Field(i).Tag.Get("tfsdk")
+		if name == "" || name == "-" || !include(name) {
+			continue
+		}
+		if s, ok := rv.Field(i).Interface().(basetypes.StringValue); ok {
+			if !s.IsNull() && !s.IsUnknown() {
+				setString(body, name, s.ValueString())
+			}
+			continue
+		}
+		value, ok := ctyValue(rv.Field(i))
+		if !ok || isEmpty(value) {
+			continue
+		}
+		body.SetAttributeValue(name, value)
+	}
+}
+
+// ctyValue converts a tfsdk model value to a cty value. The boolean is false for null and unknown values.
+func ctyValue(rv reflect.Value) (cty.Value, bool) {
+	if v, ok := rv.Interface().(attr.Value); ok {
+		return attrValue(v)
+	}
+
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return cty.NilVal, false
+		}
+		return ctyValue(rv.Elem())
+	case reflect.Slice:
+		values := make([]cty.Value, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			if value, ok := ctyValue(rv.Index(i)); ok {
+				values = append(values, value)
+			}
+		}
+		return tupleVal(values), true
+	case reflect.Struct:
+		attributes := map[string]cty.Value{}
+		for i := 0; i < rv.NumField(); i++ {
+			name := rv.Type().Field(i).Tag.Get("tfsdk")
+			if name == "" || name == "-" {
+				continue
+			}
+			if value, ok := ctyValue(rv.Field(i)); ok {
+				attributes[name] = value
+			}
+		}
+		return cty.ObjectVal(attributes), true
+	}
+	return cty.NilVal, false
+}
+
+func attrValue(v attr.Value) (cty.Value, bool) {
+	if v.IsNull() || v.IsUnknown() {
+		return cty.NilVal, false
+	}
+
+	switch t := v.(type) {
+	case basetypes.StringValue:
+		return cty.StringVal(t.ValueString()), true
+	case basetypes.BoolValue:
+		return cty.BoolVal(t.ValueBool()), true
+	case basetypes.Int64Value:
+		return cty.NumberIntVal(t.ValueInt64()), true
+	case basetypes.Float64Value:
+		return cty.NumberFloatVal(t.ValueFloat64()), true
+	case basetypes.ListValue:
+		return elementsVal(t.Elements()), true
+	case basetypes.SetValue:
+		return elementsVal(t.Elements()), true
+	case basetypes.ObjectValue:
+		attributes := map[string]cty.Value{}
+		for name, a := range t.Attributes() {
+			if value, ok := attrValue(a); ok {
+				attributes[name] = value
+			}
+		}
+		return cty.ObjectVal(attributes), true
+	case basetypes.MapValue:
+		elements := map[string]cty.Value{}
+		for key, a := range t.Elements() {
+			if value, ok := attrValue(a); ok {
+				elements[key] = value
+			}
+		}
+		return cty.ObjectVal(elements), true
+	}
+	return cty.NilVal, false
+}
+
+func elementsVal(elements []attr.Value) cty.Value {
+	values := make([]cty.Value, 0, len(elements))
+	for _, element := range elements {
+		if value, ok := attrValue(element); ok {
+			values = append(values, value)
+		}
+	}
+	return tupleVal(values)
+}
+
+func tupleVal(values []cty.Value) cty.Value {
+	if len(values) == 0 {
+		return cty.EmptyTupleVal
+	}
+	return cty.TupleVal(values)
+}
+
+func isEmpty(value cty.Value) bool {
+	switch {
+	case value.Type().IsTupleType():
+		return value.LengthInt() == 0
+	case value.Type() == cty.String:
+		return value.AsString() == ""
+	}
+	return false
+}
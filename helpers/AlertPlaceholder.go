@@ -0,0 +1,72 @@
+package helpers
+
+import (
+	"strings"
+)
+
+// AlertPlaceholder describes a placeholder that Uptrends fills in in alert messages and integration bodies,
+// e.g. {{@alert.type}}, with an example value used to preview messages.
+type AlertPlaceholder struct {
+	Name        string
+	Description string
+	Example     string
+}
+
+// FindAlertPlaceholder returns the placeholder with the given name. The comparison is case-insensitive.
+func FindAlertPlaceholder(placeholders []AlertPlaceholder, name string) (AlertPlaceholder, bool) {
+	for _, placeholder := range placeholders {
+		if strings.EqualFold(placeholder.Name, name) {
+			return placeholder, true
+		}
+	}
+	return AlertPlaceholder{}, false
+}
+
+// ClosestAlertPlaceholder returns the name of the placeholder that is closest to name, for "did you mean" hints.
+// It returns an empty string when no placeholder is within a few edits of name.
+func ClosestAlertPlaceholder(placeholders []AlertPlaceholder, name string) string {
+	best, bestDistance := "", 4
+	for _, placeholder := range placeholders {
+		distance := editDistance(strings.ToLower(placeholder.Name), strings.ToLower(name))
+		if distance < bestDistance {
+			best, bestDistance = placeholder.Name, distance
+		}
+	}
+	return best
+}
+
+// ReplacePlaceholders replaces every {{...}} placeholder in text with the value returned by lookup.
+// Placeholders for which lookup has no value are left as they are and returned in order of appearance.
+func ReplacePlaceholders(text string, lookup func(name string) (string, bool)) (string, []string) {
+	var missing []string
+	result := placeholderPattern.ReplaceAllStringFunc(text, func(match string) string {
+		name := placeholderPattern.FindStringSubmatch(match)[1]
+		if value, ok := lookup(name); ok {
+			return value
+		}
+		missing = append(missing, name)
+		return match
+	})
+	return result, missing
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
@@ -0,0 +1,22 @@
+package helpers
+
+import "fmt"
+
+// CheckIntervalRule describes the range of check intervals, in seconds, that a monitor type accepts.
+type CheckIntervalRule struct {
+	MinimumSeconds int64
+	MaximumSeconds int64
+}
+
+// ValidateCheckInterval checks that seconds lies within the allowed range.
+func (r CheckIntervalRule) ValidateCheckInterval(monitorType string, seconds int64) error {
+	if seconds >= r.MinimumSeconds && seconds <= r.MaximumSeconds {
+		return nil
+	}
+	return fmt.Errorf("a check interval of %d seconds is not allowed for monitor type %q. The allowed range is %s",
+		seconds, monitorType, formatIntervalRange(r.MinimumSeconds, r.MaximumSeconds))
+}
+
+func formatIntervalRange(minimum, maximum int64) string {
+	return fmt.Sprintf("%d to %d seconds (check_interval %d to %d minutes)", minimum, maximum, minimum/60, maximum/60)
+}
@@ -0,0 +1,99 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	minutesPerDay  = 24 * 60
+	minutesPerWeek = 7 * minutesPerDay
+)
+
+// DutyPeriod is a weekly period in which an operator is on duty, in the time zone of the operator.
+// Times use the HH:MM format. A period whose end lies before its start wraps around the end of the week.
+type DutyPeriod struct {
+	StartDay  string
+	StartTime string
+	EndDay    string
+	EndTime   string
+}
+
+// String returns the period as "Monday 09:00 - Friday 17:00".
+func (p DutyPeriod) String() string {
+	return fmt.Sprintf("%s %s - %s %s", p.StartDay, p.StartTime, p.EndDay, p.EndTime)
+}
+
+// weekRanges returns the period as half-open ranges of minutes since the start of Sunday.
+// A period that wraps around the end of the week is split in two.
+func (p DutyPeriod) weekRanges() ([][2]int, error) {
+	start, err := minuteOfWeek(p.StartDay, p.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	end, err := minuteOfWeek(p.EndDay, p.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case start == end:
+		return nil, fmt.Errorf("the duty period %s has no duration", p)
+	case start < end:
+		return [][2]int{{start, end}}, nil
+	default:
+		return [][2]int{{start, minutesPerWeek}, {0, end}}, nil
+	}
+}
+
+// ValidateDutyPeriods checks each period and reports every pair of periods that overlap.
+// Periods that only touch, where one ends when the next starts, don't overlap.
+func ValidateDutyPeriods(periods []DutyPeriod) []error {
+	var errs []error
+	ranges := make([][][2]int, len(periods))
+	for i, period := range periods {
+		periodRanges, err := period.weekRanges()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		ranges[i] = periodRanges
+	}
+
+	for i := range periods {
+		for j := i + 1; j < len(periods); j++ {
+			if rangesOverlap(ranges[i], ranges[j]) {
+				errs = append(errs, fmt.Errorf("the duty period %s overlaps %s", periods[i], periods[j]))
+			}
+		}
+	}
+	return errs
+}
+
+func rangesOverlap(left, right [][2]int) bool {
+	for _, l := range left {
+		for _, r := range right {
+			if l[0] < r[1] && r[0] < l[1] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func minuteOfWeek(day, clock string) (int, error) {
+	weekday := -1
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(d.String(), day) {
+			weekday = int(d)
+		}
+	}
+	if weekday < 0 {
+		return 0, fmt.Errorf("%q is not a day of the week", day)
+	}
+	parsed, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a time in the HH:MM format", clock)
+	}
+	return weekday*minutesPerDay + parsed.Hour()*60 + parsed.Minute(), nil
+}
@@ -0,0 +1,58 @@
+package helpers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Value formats used by ErrorConditionRule.ValueFormat.
+const (
+	ValueFormatText    = "Text"
+	ValueFormatInteger = "Integer"
+	ValueFormatDecimal = "Decimal"
+	ValueFormatBoolean = "Boolean"
+)
+
+// ErrorConditionRule describes which sub-fields an error condition type accepts,
+// the expected format of its value and the monitor types it can be used with.
+type ErrorConditionRule struct {
+	ResourceAttributes
+	ValueFormat  string
+	MonitorTypes []string
+}
+
+// SupportsMonitorType reports whether the error condition can be used with the given monitor type.
+func (r ErrorConditionRule) SupportsMonitorType(monitorType string) bool {
+	for _, t := range r.MonitorTypes {
+		if t == monitorType {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateValueFormat checks that value matches the given format.
+func ValidateValueFormat(format, value string) error {
+	switch format {
+	case ValueFormatInteger:
+		v, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil || v < 0 {
+			return fmt.Errorf("expected a non-negative whole number, got %q", value)
+		}
+	case ValueFormatDecimal:
+		v, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || v < 0 {
+			return fmt.Errorf("expected a non-negative number, got %q", value)
+		}
+	case ValueFormatBoolean:
+		if _, err := strconv.ParseBool(strings.TrimSpace(value)); err != nil {
+			return fmt.Errorf("expected true or false, got %q", value)
+		}
+	case ValueFormatText:
+		if value == "" {
+			return fmt.Errorf("expected a non-empty value")
+		}
+	}
+	return nil
+}
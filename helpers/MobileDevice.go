@@ -0,0 +1,30 @@
+package helpers
+
+import "strings"
+
+// MobileDevice describes a mobile device that browser monitors can emulate, with its native dimensions.
+type MobileDevice struct {
+	Name       string
+	Width      int64
+	Height     int64
+	PixelRatio int64
+}
+
+// FindMobileDevice returns the device with the given name. The comparison is case-insensitive.
+func FindMobileDevice(devices []MobileDevice, name string) (MobileDevice, bool) {
+	for _, device := range devices {
+		if strings.EqualFold(device.Name, name) {
+			return device, true
+		}
+	}
+	return MobileDevice{}, false
+}
+
+// MobileDeviceNames returns the names of the given devices.
+func MobileDeviceNames(devices []MobileDevice) []string {
+	names := make([]string, 0, len(devices))
+	for _, device := range devices {
+		names = append(names, device.Name)
+	}
+	return names
+}
@@ -0,0 +1,81 @@
+package helpers
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+var placeholderPattern = regexp.MustCompile(`\{\{\s*([^{}]+?)\s*\}\}`)
+
+// Variables set or read from the JavaScript of a script step, e.g. ut.variables.set("token", value).
+var scriptVariableSetPattern = regexp.MustCompile(`variables\.set\(\s*["']([^"']+)["']`)
+var scriptVariableGetPattern = regexp.MustCompile(`variables\.get\(\s*["']([^"']+)["']`)
+
+// FindPlaceholders returns the names of all {{...}} placeholders in text, in order of appearance.
+func FindPlaceholders(text string) []string {
+	var names []string
+	for _, match := range placeholderPattern.FindAllStringSubmatch(text, -1) {
+		names = append(names, match[1])
+	}
+	return names
+}
+
+// ScriptVariableReads returns the names of the variables read from the JavaScript of script steps.
+func ScriptVariableReads(script string) []string {
+	var names []string
+	for _, match := range scriptVariableGetPattern.FindAllStringSubmatch(script, -1) {
+		names = append(names, match[1])
+	}
+	return names
+}
+
+// ScriptVariableNames returns the names of the variables a transaction or multi-step API script defines itself:
+// its own predefined variables, variables extracted by its steps and variables set from step scripts.
+func ScriptVariableNames(script string) []string {
+	var names []string
+	for _, match := range scriptVariableSetPattern.FindAllStringSubmatch(script, -1) {
+		names = append(names, match[1])
+	}
+
+	var parsed interface{}
+	if err := json.Unmarshal([]byte(script), &parsed); err != nil {
+		return names
+	}
+	return append(names, collectScriptVariableNames(parsed, "")...)
+}
+
+func collectScriptVariableNames(node interface{}, key string) []string {
+	var names []string
+	switch v := node.(type) {
+	case map[string]interface{}:
+		for k, child := range v {
+			if strings.EqualFold(k, "VariableName") {
+				if name, ok := child.(string); ok && name != "" {
+					names = append(names, name)
+				}
+				continue
+			}
+			names = append(names, collectScriptVariableNames(child, k)...)
+		}
+	case []interface{}:
+		nameKey := ""
+		switch {
+		case strings.EqualFold(key, "Variables"):
+			nameKey = "Name"
+		case strings.EqualFold(key, "PredefinedVariables"):
+			nameKey = "Key"
+		}
+		for _, child := range v {
+			if obj, ok := child.(map[string]interface{}); ok && nameKey != "" {
+				for k, value := range obj {
+					if name, ok := value.(string); ok && name != "" && strings.EqualFold(k, nameKey) {
+						names = append(names, name)
+					}
+				}
+			}
+			names = append(names, collectScriptVariableNames(child, "")...)
+		}
+	}
+	return names
+}
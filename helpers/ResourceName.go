@@ -0,0 +1,28 @@
+package helpers
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var invalidResourceNameCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// TerraformResourceName turns name into a valid Terraform resource name, e.g. "Web shop (EU)" becomes "web_shop_eu".
+// Names already in use get a numeric suffix; the returned name is added to used.
+func TerraformResourceName(name string, used map[string]bool) string {
+	base := strings.Trim(invalidResourceNameCharacters.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if base == "" {
+		base = "unnamed"
+	}
+	if base[0] >= '0' && base[0] <= '9' {
+		base = "_" + base
+	}
+
+	result := base
+	for i := 2; used[result]; i++ {
+		result = fmt.Sprintf("%s_%d", base, i)
+	}
+	used[result] = true
+	return result
+}
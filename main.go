@@ -4,12 +4,21 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/itrs-group/terraform-provider-itrs-uptrends/export"
 	"github.com/itrs-group/terraform-provider-itrs-uptrends/provider"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := export.Run(os.Args[2:], os.Stderr); err != nil {
+			log.Fatalf("Failed to export the account: %v", err)
+		}
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
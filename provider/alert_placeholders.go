@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/itrs-group/terraform-provider-itrs-uptrends/constants"
+	"github.com/itrs-group/terraform-provider-itrs-uptrends/helpers"
+)
+
+// validateAlertPlaceholders warns about the alert placeholders in text, the ones starting with @, that aren't in
+// constants.AlertPlaceholders. That list only holds the documented placeholders, so an unknown one may still be
+// filled in by Uptrends and is not rejected. It returns the names of the other placeholders, which are integration variables.
+func validateAlertPlaceholders(attrPath path.Path, text string, diags *diag.Diagnostics) []string {
+	var variables []string
+	for _, name := range helpers.FindPlaceholders(text) {
+		if !strings.HasPrefix(name, "@") {
+			variables = append(variables, name)
+			continue
+		}
+		if _, ok := helpers.FindAlertPlaceholder(constants.AlertPlaceholders, name); ok {
+			continue
+		}
+		diags.AddAttributeWarning(attrPath, "Unknown alert placeholder", unknownAlertPlaceholderText(name))
+	}
+	return variables
+}
+
+// warnForVariablePlaceholders warns about placeholders in an escalation level message that aren't alert
+// placeholders. Messages have no variables, so these are sent as they are.
+func warnForVariablePlaceholders(attrPath path.Path, names []string, diags *diag.Diagnostics) {
+	for _, name := range names {
+		detail := fmt.Sprintf("{{%s}} is not an alert placeholder and is sent as it is.", name)
+		if closest := helpers.ClosestAlertPlaceholder(constants.AlertPlaceholders, "@"+name); closest != "" {
+			detail += fmt.Sprintf(" Did you mean {{%s}}?", closest)
+		}
+		diags.AddAttributeWarning(attrPath, "Placeholder is not filled in", detail)
+	}
+}
+
+// unknownAlertPlaceholderText describes an unknown alert placeholder, with the closest known placeholder as a hint.
+func unknownAlertPlaceholderText(name string) string {
+	text := fmt.Sprintf("{{%s}} is not a documented Uptrends alert placeholder.", name)
+	if closest := helpers.ClosestAlertPlaceholder(constants.AlertPlaceholders, name); closest != "" {
+		text += fmt.Sprintf(" Did you mean {{%s}}?", closest)
+	}
+	return text
+}
+
+// uniqueStrings returns values without duplicates, in order of first appearance.
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	var unique []string
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
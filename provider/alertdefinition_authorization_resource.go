@@ -0,0 +1,304 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	interfaces "github.com/itrs-group/terraform-provider-itrs-uptrends/client/interfaces"
+	models "github.com/itrs-group/terraform-provider-itrs-uptrends/client/models"
+)
+
+var _ resource.Resource = &alertDefinitionAuthorizationResource{}
+
+type alertDefinitionAuthorizationResource struct {
+	client interfaces.IAlertDefinitionAuthorization
+}
+
+func NewAlertDefinitionAuthorizationResource(client interfaces.IAlertDefinitionAuthorization) resource.Resource {
+	return &alertDefinitionAuthorizationResource{
+		client: client,
+	}
+}
+
+type alertDefinitionAuthorizationModel struct {
+	ID                types.String `tfsdk:"id"`
+	AlertDefinitionID types.String `tfsdk:"alertdefinition_id"`
+	AuthorizationType types.String `tfsdk:"permission"`
+	OperatorID        types.String `tfsdk:"operator_id"`
+	OperatorGroupID   types.String `tfsdk:"operatorgroup_id"`
+}
+
+// alertDefinitionAuthorizationIdentityModel is the resource identity of itrs-uptrends_alertdefinition_authorization.
+type alertDefinitionAuthorizationIdentityModel struct {
+	AlertDefinitionID types.String `tfsdk:"alertdefinition_id"`
+	AuthorizationID   types.String `tfsdk:"authorization_id"`
+}
+
+func (i alertDefinitionAuthorizationIdentityModel) importID() string {
+	return fmt.Sprintf("%s:%s", i.AlertDefinitionID.ValueString(), i.AuthorizationID.ValueString())
+}
+
+// identity returns the resource identity of the alert definition authorization, taking the authorization ID from the composite ID.
+func (m alertDefinitionAuthorizationModel) identity() alertDefinitionAuthorizationIdentityModel {
+	authorizationID := types.StringNull()
+	if parts := strings.Split(m.ID.ValueString(), ":"); len(parts) == 2 {
+		authorizationID = types.StringValue(parts[1])
+	}
+	return alertDefinitionAuthorizationIdentityModel{
+		AlertDefinitionID: m.AlertDefinitionID,
+		AuthorizationID:   authorizationID,
+	}
+}
+
+func (r *alertDefinitionAuthorizationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "itrs-uptrends_alertdefinition_authorization"
+}
+
+func (r *alertDefinitionAuthorizationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			"id": rschema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the authorization (composite key in format `alertdefinition_id:authorization_id`).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"alertdefinition_id": rschema.StringAttribute{
+				Required:    true,
+				Description: "The GUID of the alert definition.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permission": rschema.StringAttribute{
+				Required:    true,
+				Description: "The authorization type. Valid values: `ViewAlertDefinition`, `EditAlertDefinition`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"ViewAlertDefinition",
+						"EditAlertDefinition",
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"operator_id": rschema.StringAttribute{
+				Optional:    true,
+				Description: "The GUID of the operator. Provide this or `operatorgroup_id`, not both.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("operator_id"),
+						path.MatchRoot("operatorgroup_id"),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"operatorgroup_id": rschema.StringAttribute{
+				Optional:    true,
+				Description: "The GUID of the operator group. Provide this or `operator_id`, not both.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *alertDefinitionAuthorizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state alertDefinitionAuthorizationModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, state.identity())...)
+
+	parts := strings.Split(state.ID.ValueString(), ":")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError("Invalid ID", "Expected ID in the format `alertdefinition_id:authorization_id`.")
+		return
+	}
+	alertDefinitionID := parts[0]
+	authorizationID := parts[1]
+
+	authorizations, err := r.client.GetAlertDefinitionAuthorizations(alertDefinitionID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading alert definition authorization",
+			fmt.Sprintf("Could not retrieve authorizations for alert definition %q: %s", alertDefinitionID, err.Error()),
+		)
+		return
+	}
+
+	found := false
+	for _, auth := range authorizations {
+		if auth.AuthorizationId == authorizationID {
+			state.AlertDefinitionID = types.StringValue(alertDefinitionID)
+			state.AuthorizationType = types.StringValue(auth.AuthorizationType)
+			if auth.OperatorGuid != "" {
+				state.OperatorID = types.StringValue(auth.OperatorGuid)
+			} else {
+				state.OperatorID = types.StringNull()
+			}
+			if auth.OperatorGroupGuid != "" {
+				state.OperatorGroupID = types.StringValue(auth.OperatorGroupGuid)
+			} else {
+				state.OperatorGroupID = types.StringNull()
+			}
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *alertDefinitionAuthorizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan alertDefinitionAuthorizationModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	auth := models.AlertDefinitionAuthorization{
+		AuthorizationType: plan.AuthorizationType.ValueString(),
+	}
+	if !plan.OperatorID.IsNull() && plan.OperatorID.ValueString() != "" {
+		auth.OperatorGuid = plan.OperatorID.ValueString()
+	}
+	if !plan.OperatorGroupID.IsNull() && plan.OperatorGroupID.ValueString() != "" {
+		auth.OperatorGroupGuid = plan.OperatorGroupID.ValueString()
+	}
+
+	created, err := r.client.CreateAlertDefinitionAuthorization(plan.AlertDefinitionID.ValueString(), auth)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating alert definition authorization",
+			fmt.Sprintf("Could not create authorization for alert definition %q: %s", plan.AlertDefinitionID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", plan.AlertDefinitionID.ValueString(), created.AuthorizationId))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, plan.identity())...)
+}
+
+func (r *alertDefinitionAuthorizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+func (r *alertDefinitionAuthorizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state alertDefinitionAuthorizationModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts := strings.Split(state.ID.ValueString(), ":")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError("Invalid ID", "Expected ID in the format `alertdefinition_id:authorization_id`.")
+		return
+	}
+	alertDefinitionID := parts[0]
+	authorizationID := parts[1]
+
+	if err := r.client.DeleteAlertDefinitionAuthorization(alertDefinitionID, authorizationID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting alert definition authorization",
+			fmt.Sprintf("Could not delete authorization %q from alert definition %q: %s", authorizationID, alertDefinitionID, err.Error()),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *alertDefinitionAuthorizationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"alertdefinition_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "GUID of the alert definition.",
+			},
+			"authorization_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "ID of the authorization in the alert definition.",
+			},
+		},
+	}
+}
+
+func (r *alertDefinitionAuthorizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity alertDefinitionAuthorizationIdentityModel
+	importID, diags := importStateID(ctx, req, &identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts := strings.Split(importID, ":")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Error importing resource",
+			"Expected ID in the format `alertdefinition_id:authorization_id`.",
+		)
+		return
+	}
+
+	alertDefinitionID := parts[0]
+	authorizationID := parts[1]
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("alertdefinition_id"), alertDefinitionID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importID)...)
+
+	authorizations, err := r.client.GetAlertDefinitionAuthorizations(alertDefinitionID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing alert definition authorization",
+			fmt.Sprintf("Could not retrieve authorizations for alert definition %q: %s", alertDefinitionID, err.Error()),
+		)
+		return
+	}
+
+	for _, auth := range authorizations {
+		if auth.AuthorizationId == authorizationID {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permission"), auth.AuthorizationType)...)
+			if auth.OperatorGuid != "" {
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("operator_id"), auth.OperatorGuid)...)
+			}
+			if auth.OperatorGroupGuid != "" {
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("operatorgroup_id"), auth.OperatorGroupGuid)...)
+			}
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"Authorization not found",
+		fmt.Sprintf("No authorization %q found for alert definition %q.", authorizationID, alertDefinitionID),
+	)
+}
@@ -155,7 +155,7 @@ func (d *alertdefinitionDataSource) Read(ctx context.Context, req datasource.Rea
 		}
 	}
 
-	levels, err := d.client.GetEscalationLevels(state.AlertDefinitionGuid.ValueString())
+	levels, _, err := d.client.GetEscalationLevels(state.AlertDefinitionGuid.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error fetching escalation levels", err.Error())
 		return
@@ -0,0 +1,407 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	interfaces "github.com/itrs-group/terraform-provider-itrs-uptrends/client/interfaces"
+	models "github.com/itrs-group/terraform-provider-itrs-uptrends/client/models"
+)
+
+var _ resource.Resource = &alertDefinitionEscalationLevelResource{}
+var _ resource.ResourceWithIdentity = &alertDefinitionEscalationLevelResource{}
+var _ resource.ResourceWithValidateConfig = &alertDefinitionEscalationLevelResource{}
+var _ resource.ResourceWithImportState = &alertDefinitionEscalationLevelResource{}
+
+// alertDefinitionEscalationLevelResource manages a single escalation level of an alert definition.
+type alertDefinitionEscalationLevelResource struct {
+	client interfaces.IAlertDefinition
+}
+
+// NewAlertDefinitionEscalationLevelResource returns a new instance of the resource.
+func NewAlertDefinitionEscalationLevelResource(client interfaces.IAlertDefinition) resource.Resource {
+	return &alertDefinitionEscalationLevelResource{client: client}
+}
+
+type alertDefinitionEscalationLevelModel struct {
+	ID                  types.String `tfsdk:"id"`
+	AlertDefinitionID   types.String `tfsdk:"alertdefinition_id"`
+	EscalationLevelID   types.Int64  `tfsdk:"escalation_level_id"`
+	EscalationMode      types.String `tfsdk:"escalation_mode"`
+	ThresholdErrorCount types.Int64  `tfsdk:"threshold_error_count"`
+	ThresholdMinutes    types.Int64  `tfsdk:"threshold_minutes"`
+	IsActive            types.Bool   `tfsdk:"is_active"`
+	Message             types.String `tfsdk:"message"`
+	NumberOfReminders   types.Int64  `tfsdk:"number_of_reminders"`
+	ReminderDelay       types.Int64  `tfsdk:"reminder_delay"`
+	IncludeTraceRoute   types.Bool   `tfsdk:"include_trace_route"`
+}
+
+// alertDefinitionEscalationLevelIdentityModel is the resource identity of itrs-uptrends_alertdefinition_escalation_level.
+type alertDefinitionEscalationLevelIdentityModel struct {
+	AlertDefinitionID types.String `tfsdk:"alertdefinition_id"`
+	EscalationLevelID types.Int64  `tfsdk:"escalation_level_id"`
+}
+
+func (i alertDefinitionEscalationLevelIdentityModel) importID() string {
+	return fmt.Sprintf("%s:%d", i.AlertDefinitionID.ValueString(), i.EscalationLevelID.ValueInt64())
+}
+
+func (m alertDefinitionEscalationLevelModel) identity() alertDefinitionEscalationLevelIdentityModel {
+	return alertDefinitionEscalationLevelIdentityModel{
+		AlertDefinitionID: m.AlertDefinitionID,
+		EscalationLevelID: m.EscalationLevelID,
+	}
+}
+
+func (r *alertDefinitionEscalationLevelResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "itrs-uptrends_alertdefinition_escalation_level"
+}
+
+func (r *alertDefinitionEscalationLevelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages one escalation level of an alert definition. Escalation levels always exist on an alert definition, so creating this resource takes over the settings of the level and destroying it deactivates the level.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Composite identifier in format alertdefinition_id:escalation_level_id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"alertdefinition_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The GUID of the alert definition.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"escalation_level_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The escalation level ID (1-4).",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.OneOf(1, 2, 3, 4),
+				},
+			},
+			"escalation_mode": schema.StringAttribute{
+				Required:    true,
+				Description: "Escalation mode: AlertOnErrorCount or AlertOnErrorDuration.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("AlertOnErrorCount", "AlertOnErrorDuration"),
+				},
+			},
+			"is_active": schema.BoolAttribute{
+				Required:    true,
+				Description: "Whether the escalation level is active.",
+			},
+			"threshold_error_count": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Threshold for error count. This can be updated when escalation mode is AlertOnErrorCount",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"threshold_minutes": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Threshold for minutes. This can be updated when escalation mode is AlertOnErrorDuration",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"message": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Message for the escalation level.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"number_of_reminders": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Number of reminders.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"reminder_delay": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Delay between reminders in minutes.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"include_trace_route": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether to include trace route.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the alert placeholders in the message.
+func (r *alertDefinitionEscalationLevelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var message types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("message"), &message)...)
+	if resp.Diagnostics.HasError() || message.IsNull() || message.IsUnknown() {
+		return
+	}
+
+	variables := validateAlertPlaceholders(path.Root("message"), message.ValueString(), &resp.Diagnostics)
+	warnForVariablePlaceholders(path.Root("message"), variables, &resp.Diagnostics)
+}
+
+func (r *alertDefinitionEscalationLevelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan alertDefinitionEscalationLevelModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(&plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, plan.identity())...)
+}
+
+func (r *alertDefinitionEscalationLevelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state alertDefinitionEscalationLevelModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, state.identity())...)
+
+	level := r.getEscalationLevel(state.AlertDefinitionID.ValueString(), int(state.EscalationLevelID.ValueInt64()), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if level == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	updateEscalationLevelState(&state, level)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *alertDefinitionEscalationLevelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan alertDefinitionEscalationLevelModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(&plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, plan.identity())...)
+}
+
+// Delete deactivates the escalation level. The level itself can't be removed from the alert definition.
+func (r *alertDefinitionEscalationLevelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state alertDefinitionEscalationLevelModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alertDefGuid := state.AlertDefinitionID.ValueString()
+	escalationLevelId := int(state.EscalationLevelID.ValueInt64())
+
+	level := r.getEscalationLevel(alertDefGuid, escalationLevelId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || level == nil || !level.IsActive {
+		return
+	}
+
+	level.AlertDefinitionGuid = alertDefGuid
+	level.IsActive = false
+	if err := r.client.UpdateEscalationLevel(*level); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deactivating escalation level",
+			fmt.Sprintf("Could not deactivate escalation level %d of alert definition %s: %s", escalationLevelId, alertDefGuid, err),
+		)
+	}
+}
+
+func (r *alertDefinitionEscalationLevelResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"alertdefinition_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "GUID of the alert definition.",
+			},
+			"escalation_level_id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "Escalation level ID (1-4).",
+			},
+		},
+	}
+}
+
+func (r *alertDefinitionEscalationLevelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity alertDefinitionEscalationLevelIdentityModel
+	importID, diags := importStateID(ctx, req, &identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(importID, ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected format: alertdefinition_id:escalation_level_id. Got: %q", importID),
+		)
+		return
+	}
+
+	escalationLevelId, err := strconv.ParseInt(idParts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid escalation_level_id",
+			fmt.Sprintf("Could not parse escalation_level_id %q as integer: %s", idParts[1], err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("alertdefinition_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("escalation_level_id"), escalationLevelId)...)
+}
+
+// apply PATCHes the escalation level with the plan and maps the level read back from the API onto the plan.
+// The PATCH sends every field, so fields that are not configured keep the value the level currently has.
+func (r *alertDefinitionEscalationLevelResource) apply(plan *alertDefinitionEscalationLevelModel, diags *diag.Diagnostics) {
+	alertDefGuid := plan.AlertDefinitionID.ValueString()
+	escalationLevelId := int(plan.EscalationLevelID.ValueInt64())
+
+	current := r.getEscalationLevel(alertDefGuid, escalationLevelId, diags)
+	if diags.HasError() {
+		return
+	}
+	if current == nil {
+		diags.AddError(
+			"Escalation level not found",
+			fmt.Sprintf("Alert definition %s has no escalation level %d.", alertDefGuid, escalationLevelId),
+		)
+		return
+	}
+
+	payload := *current
+	payload.AlertDefinitionGuid = alertDefGuid
+	payload.Id = escalationLevelId
+	payload.EscalationMode = plan.EscalationMode.ValueString()
+	payload.IsActive = plan.IsActive.ValueBool()
+	if !plan.ThresholdErrorCount.IsNull() && !plan.ThresholdErrorCount.IsUnknown() {
+		payload.ThresholdErrorCount = int(plan.ThresholdErrorCount.ValueInt64())
+	}
+	if !plan.ThresholdMinutes.IsNull() && !plan.ThresholdMinutes.IsUnknown() {
+		payload.ThresholdMinutes = int(plan.ThresholdMinutes.ValueInt64())
+	}
+	if !plan.Message.IsNull() && !plan.Message.IsUnknown() {
+		payload.Message = plan.Message.ValueString()
+	}
+	if !plan.NumberOfReminders.IsNull() && !plan.NumberOfReminders.IsUnknown() {
+		payload.NumberOfReminders = int(plan.NumberOfReminders.ValueInt64())
+	}
+	if !plan.ReminderDelay.IsNull() && !plan.ReminderDelay.IsUnknown() {
+		payload.ReminderDelay = int(plan.ReminderDelay.ValueInt64())
+	}
+	if !plan.IncludeTraceRoute.IsNull() && !plan.IncludeTraceRoute.IsUnknown() {
+		payload.IncludeTraceRoute = plan.IncludeTraceRoute.ValueBool()
+	}
+
+	if err := r.client.UpdateEscalationLevel(payload); err != nil {
+		diags.AddError(
+			"Error updating escalation level",
+			fmt.Sprintf("Could not update escalation level %d of alert definition %s: %s", escalationLevelId, alertDefGuid, err),
+		)
+		return
+	}
+
+	updated := r.getEscalationLevel(alertDefGuid, escalationLevelId, diags)
+	if diags.HasError() {
+		return
+	}
+	if updated == nil {
+		updated = &payload
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%d", alertDefGuid, escalationLevelId))
+	updateEscalationLevelState(plan, updated)
+}
+
+// getEscalationLevel returns the escalation level of the alert definition, or nil when the alert definition
+// doesn't exist or has no such level.
+func (r *alertDefinitionEscalationLevelResource) getEscalationLevel(alertDefGuid string, escalationLevelId int, diags *diag.Diagnostics) *models.EscalationLevel {
+	levels, statusCode, err := r.client.GetEscalationLevels(alertDefGuid)
+	if statusCode == http.StatusNotFound {
+		return nil
+	}
+	if err != nil {
+		diags.AddError(
+			"Error reading escalation levels",
+			fmt.Sprintf("Could not read escalation levels for alert definition %s: %s", alertDefGuid, err),
+		)
+		return nil
+	}
+	for i := range levels {
+		if levels[i].Id == escalationLevelId {
+			return &levels[i]
+		}
+	}
+	return nil
+}
+
+// updateEscalationLevelState maps an escalation level returned by the API onto the state.
+func updateEscalationLevelState(state *alertDefinitionEscalationLevelModel, level *models.EscalationLevel) {
+	state.EscalationMode = types.StringValue(level.EscalationMode)
+	state.ThresholdErrorCount = types.Int64Value(int64(level.ThresholdErrorCount))
+	state.ThresholdMinutes = types.Int64Value(int64(level.ThresholdMinutes))
+	state.IsActive = types.BoolValue(level.IsActive)
+	state.Message = types.StringValue(level.Message)
+	state.NumberOfReminders = types.Int64Value(int64(level.NumberOfReminders))
+	state.ReminderDelay = types.Int64Value(int64(level.ReminderDelay))
+	state.IncludeTraceRoute = types.BoolValue(level.IncludeTraceRoute)
+}
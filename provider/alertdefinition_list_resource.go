@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	interfaces "github.com/itrs-group/terraform-provider-itrs-uptrends/client/interfaces"
+)
+
+var _ list.ListResource = &alertDefinitionListResource{}
+
+// NewAlertDefinitionListResource constructs the list resource for alert definitions.
+func NewAlertDefinitionListResource(client interfaces.IAlertDefinition) list.ListResource {
+	return &alertDefinitionListResource{client: client}
+}
+
+type alertDefinitionListResource struct {
+	client interfaces.IAlertDefinition
+}
+
+type alertDefinitionListConfigModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+	IsActive  types.Bool   `tfsdk:"is_active"`
+}
+
+func (r *alertDefinitionListResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "itrs-uptrends_alertdefinition"
+}
+
+func (r *alertDefinitionListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists the alert definitions in the account, optionally filtered.",
+		Attributes: map[string]listschema.Attribute{
+			"name_regex": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list alert definitions whose name matches this regular expression.",
+			},
+			"is_active": listschema.BoolAttribute{
+				Optional:    true,
+				Description: "Only list active (true) or inactive (false) alert definitions.",
+			},
+		},
+	}
+}
+
+func (r *alertDefinitionListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config alertDefinitionListConfigModel
+	diags := req.Config.Get(ctx, &config)
+	nameRegex, regexDiags := compileNameRegex(config.NameRegex)
+	diags.Append(regexDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	alertDefinitions, statusCode, responseBody, err := r.client.GetAlertDefinitions()
+	if err != nil {
+		diags.AddError("Error listing alert definitions", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	if statusCode >= 300 {
+		diags.AddError(
+			"Failed to list alert definitions",
+			fmt.Sprintf("HTTP status code: %d with response body %v", statusCode, responseBody),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	results := make([]list.ListResult, 0, len(alertDefinitions))
+	for _, alertDefinition := range alertDefinitions {
+		if nameRegex != nil && !nameRegex.MatchString(alertDefinition.AlertName) {
+			continue
+		}
+		if !config.IsActive.IsNull() && alertDefinition.IsActive != config.IsActive.ValueBool() {
+			continue
+		}
+		result := newGuidListResult(ctx, req, alertDefinition.AlertDefinitionGuid, alertDefinition.AlertName)
+		if req.IncludeResource {
+			state := alertDefinitionResourceModel{
+				AlertDefinitionGuid: types.StringValue(alertDefinition.AlertDefinitionGuid),
+				Name:                types.StringValue(alertDefinition.AlertName),
+				IsActive:            types.BoolValue(alertDefinition.IsActive),
+			}
+			escalationLevels, _, err := r.client.GetEscalationLevels(alertDefinition.AlertDefinitionGuid)
+			if err != nil {
+				result.Diagnostics.AddError(
+					"Error reading escalation levels",
+					fmt.Sprintf("Could not read escalation levels for alert definition %s: %s", alertDefinition.AlertDefinitionGuid, err),
+				)
+			} else {
+				levels, levelDiags := escalationLevelsState(ctx, escalationLevels)
+				result.Diagnostics.Append(levelDiags...)
+				state.EscalationLevels = levels
+				result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+			}
+		}
+		results = append(results, result)
+	}
+	streamListResults(req, results, stream)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -33,6 +34,23 @@ type alertDefinitionMonitorMembershipModel struct {
 	MonitorID         types.String `tfsdk:"monitor_id"`
 }
 
+// alertDefinitionMonitorMembershipIdentityModel is the resource identity of itrs-uptrends_alertdefinition_monitor_membership.
+type alertDefinitionMonitorMembershipIdentityModel struct {
+	AlertDefinitionID types.String `tfsdk:"alertdefinition_id"`
+	MonitorID         types.String `tfsdk:"monitor_id"`
+}
+
+func (i alertDefinitionMonitorMembershipIdentityModel) importID() string {
+	return fmt.Sprintf("%s:%s", i.AlertDefinitionID.ValueString(), i.MonitorID.ValueString())
+}
+
+func (m alertDefinitionMonitorMembershipModel) identity() alertDefinitionMonitorMembershipIdentityModel {
+	return alertDefinitionMonitorMembershipIdentityModel{
+		AlertDefinitionID: m.AlertDefinitionID,
+		MonitorID:         m.MonitorID,
+	}
+}
+
 // Metadata returns the resource type name.
 func (r *alertDefinitionMonitorMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = "itrs-uptrends_alertdefinition_monitor_membership"
@@ -90,6 +108,7 @@ func (r *alertDefinitionMonitorMembershipResource) Create(ctx context.Context, r
 	// Set state.
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, plan.identity())...)
 }
 
 // Read refreshes the resource state.
@@ -100,6 +119,7 @@ func (r *alertDefinitionMonitorMembershipResource) Read(ctx context.Context, req
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, state.identity())...)
 
 	// Verify that the assignment still exists.
 	assignments, err := r.client.GetAssignments(state.AlertDefinitionID.ValueString())
@@ -152,14 +172,36 @@ func (r *alertDefinitionMonitorMembershipResource) Delete(ctx context.Context, r
 	// Upon successful deletion, Terraform automatically removes the state.
 }
 
+func (r *alertDefinitionMonitorMembershipResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"alertdefinition_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "GUID of the alert definition.",
+			},
+			"monitor_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "GUID of the monitor.",
+			},
+		},
+	}
+}
+
 func (r *alertDefinitionMonitorMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity alertDefinitionMonitorMembershipIdentityModel
+	importID, diags := importStateID(ctx, req, &identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Split the import ID into alertdefinition_id and monitor_id
-	idParts := strings.Split(req.ID, ":")
+	idParts := strings.Split(importID, ":")
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: alertdefinition_id:monitor_id. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: alertdefinition_id:monitor_id. Got: %q", importID),
 		)
 		return
 	}
@@ -169,5 +211,5 @@ func (r *alertDefinitionMonitorMembershipResource) ImportState(ctx context.Conte
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("monitor_id"), idParts[1])...)
 
 	// Set the ID attribute in the state
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importID)...)
 }
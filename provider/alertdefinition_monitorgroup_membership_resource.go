@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -33,6 +34,23 @@ type alertDefinitionMonitorGroupMembershipModel struct {
 	MonitorGroupID    types.String `tfsdk:"monitorgroup_id"`
 }
 
+// alertDefinitionMonitorGroupMembershipIdentityModel is the resource identity of itrs-uptrends_alertdefinition_monitorgroup_membership.
+type alertDefinitionMonitorGroupMembershipIdentityModel struct {
+	AlertDefinitionID types.String `tfsdk:"alertdefinition_id"`
+	MonitorGroupID    types.String `tfsdk:"monitorgroup_id"`
+}
+
+func (i alertDefinitionMonitorGroupMembershipIdentityModel) importID() string {
+	return fmt.Sprintf("%s:%s", i.AlertDefinitionID.ValueString(), i.MonitorGroupID.ValueString())
+}
+
+func (m alertDefinitionMonitorGroupMembershipModel) identity() alertDefinitionMonitorGroupMembershipIdentityModel {
+	return alertDefinitionMonitorGroupMembershipIdentityModel{
+		AlertDefinitionID: m.AlertDefinitionID,
+		MonitorGroupID:    m.MonitorGroupID,
+	}
+}
+
 // Metadata returns the resource type name.
 func (r *alertDefinitionMonitorGroupMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = "itrs-uptrends_alertdefinition_monitorgroup_membership"
@@ -90,6 +108,7 @@ func (r *alertDefinitionMonitorGroupMembershipResource) Create(ctx context.Conte
 	// Set state.
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, plan.identity())...)
 }
 
 // Read refreshes the resource state.
@@ -100,6 +119,7 @@ func (r *alertDefinitionMonitorGroupMembershipResource) Read(ctx context.Context
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, state.identity())...)
 
 	// Verify that the assignment still exists.
 	assignments, err := r.client.GetMonitorGroupAssignments(state.AlertDefinitionID.ValueString())
@@ -152,14 +172,36 @@ func (r *alertDefinitionMonitorGroupMembershipResource) Delete(ctx context.Conte
 	// Upon successful deletion, Terraform automatically removes the state.
 }
 
+func (r *alertDefinitionMonitorGroupMembershipResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"alertdefinition_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "GUID of the alert definition.",
+			},
+			"monitorgroup_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "GUID of the monitor group.",
+			},
+		},
+	}
+}
+
 func (r *alertDefinitionMonitorGroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity alertDefinitionMonitorGroupMembershipIdentityModel
+	importID, diags := importStateID(ctx, req, &identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Split the import ID into alertdefinition_id and monitorgroup_id
-	idParts := strings.Split(req.ID, ":")
+	idParts := strings.Split(importID, ":")
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: alertdefinition_id:monitorgroup_id. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: alertdefinition_id:monitorgroup_id. Got: %q", importID),
 		)
 		return
 	}
@@ -169,5 +211,5 @@ func (r *alertDefinitionMonitorGroupMembershipResource) ImportState(ctx context.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("monitorgroup_id"), idParts[1])...)
 
 	// Set the ID attribute in the state
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importID)...)
 }
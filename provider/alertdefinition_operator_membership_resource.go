@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -42,6 +43,25 @@ type alertDefinitionOperatorMembershipResourceModel struct {
 	EscalationLevel   types.Int64  `tfsdk:"escalationlevel"`
 }
 
+// alertDefinitionOperatorMembershipIdentityModel is the resource identity of itrs-uptrends_alertdefinition_operator_membership.
+type alertDefinitionOperatorMembershipIdentityModel struct {
+	AlertDefinitionID types.String `tfsdk:"alertdefinition_id"`
+	OperatorID        types.String `tfsdk:"operator_id"`
+	EscalationLevel   types.Int64  `tfsdk:"escalationlevel"`
+}
+
+func (i alertDefinitionOperatorMembershipIdentityModel) importID() string {
+	return fmt.Sprintf("%s:%s:%d", i.AlertDefinitionID.ValueString(), i.OperatorID.ValueString(), i.EscalationLevel.ValueInt64())
+}
+
+func (m alertDefinitionOperatorMembershipResourceModel) identity() alertDefinitionOperatorMembershipIdentityModel {
+	return alertDefinitionOperatorMembershipIdentityModel{
+		AlertDefinitionID: m.AlertDefinitionID,
+		OperatorID:        m.OperatorID,
+		EscalationLevel:   m.EscalationLevel,
+	}
+}
+
 // Metadata returns the resource type name.
 func (r *alertDefinitionOperatorMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = "itrs-uptrends_alertdefinition_operator_membership"
@@ -112,6 +132,7 @@ func (r *alertDefinitionOperatorMembershipResource) Create(ctx context.Context,
 	plan.ID = types.StringValue(fmt.Sprintf("%s:%s:%d", apiResp.AlertDefinition, apiResp.Operator, apiResp.Escalationlevel))
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, plan.identity())...)
 }
 
 // Read refreshes the resource state.
@@ -122,6 +143,7 @@ func (r *alertDefinitionOperatorMembershipResource) Read(ctx context.Context, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, state.identity())...)
 
 	// Retrieve memberships using the client.
 	memberships, err := r.client.GetMembership(
@@ -184,15 +206,41 @@ func (r *alertDefinitionOperatorMembershipResource) Delete(ctx context.Context,
 	}
 }
 
+func (r *alertDefinitionOperatorMembershipResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"alertdefinition_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "GUID of the alert definition.",
+			},
+			"operator_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "GUID of the operator.",
+			},
+			"escalationlevel": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "Escalation level (1-4).",
+			},
+		},
+	}
+}
+
 func (r *alertDefinitionOperatorMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity alertDefinitionOperatorMembershipIdentityModel
+	importID, diags := importStateID(ctx, req, &identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Split the import ID into alertdefinition_id, operator_id, and escalationlevel
-	idParts := strings.Split(req.ID, ":")
+	idParts := strings.Split(importID, ":")
 
 	// Validate the format of the import ID
 	if len(idParts) != 3 {
 		resp.Diagnostics.AddError(
 			"Invalid Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: alertdefinition_id:operator_id:escalationlevel. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: alertdefinition_id:operator_id:escalationlevel. Got: %q", importID),
 		)
 		return
 	}
@@ -201,7 +249,7 @@ func (r *alertDefinitionOperatorMembershipResource) ImportState(ctx context.Cont
 	if idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		resp.Diagnostics.AddError(
 			"Invalid Import Identifier",
-			fmt.Sprintf("One or more parts of the import identifier are empty. Got: %q", req.ID),
+			fmt.Sprintf("One or more parts of the import identifier are empty. Got: %q", importID),
 		)
 		return
 	}
@@ -222,5 +270,5 @@ func (r *alertDefinitionOperatorMembershipResource) ImportState(ctx context.Cont
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("escalationlevel"), escalationLevel)...)
 
 	// Set the ID attribute in the state
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importID)...)
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -42,6 +43,25 @@ type alertDefinitionOperatorGroupMembershipResourceModel struct {
 	EscalationLevel   types.Int64  `tfsdk:"escalationlevel"`
 }
 
+// alertDefinitionOperatorGroupMembershipIdentityModel is the resource identity of itrs-uptrends_alertdefinition_operatorgroup_membership.
+type alertDefinitionOperatorGroupMembershipIdentityModel struct {
+	AlertDefinitionID types.String `tfsdk:"alertdefinition_id"`
+	OperatorGroupID   types.String `tfsdk:"operatorgroup_id"`
+	EscalationLevel   types.Int64  `tfsdk:"escalationlevel"`
+}
+
+func (i alertDefinitionOperatorGroupMembershipIdentityModel) importID() string {
+	return fmt.Sprintf("%s:%s:%d", i.AlertDefinitionID.ValueString(), i.OperatorGroupID.ValueString(), i.EscalationLevel.ValueInt64())
+}
+
+func (m alertDefinitionOperatorGroupMembershipResourceModel) identity() alertDefinitionOperatorGroupMembershipIdentityModel {
+	return alertDefinitionOperatorGroupMembershipIdentityModel{
+		AlertDefinitionID: m.AlertDefinitionID,
+		OperatorGroupID:   m.OperatorGroupID,
+		EscalationLevel:   m.EscalationLevel,
+	}
+}
+
 // Metadata returns the resource type name.
 func (r *alertDefinitionOperatorGroupMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = "itrs-uptrends_alertdefinition_operatorgroup_membership"
@@ -112,6 +132,7 @@ func (r *alertDefinitionOperatorGroupMembershipResource) Create(ctx context.Cont
 	plan.ID = types.StringValue(fmt.Sprintf("%s:%s:%d", apiResp.AlertDefinition, apiResp.OperatorGroup, apiResp.Escalationlevel))
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, plan.identity())...)
 }
 
 // Read refreshes the resource state.
@@ -122,6 +143,7 @@ func (r *alertDefinitionOperatorGroupMembershipResource) Read(ctx context.Contex
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, state.identity())...)
 
 	// Retrieve memberships using the client.
 	memberships, err := r.client.GetMembership(
@@ -184,15 +206,41 @@ func (r *alertDefinitionOperatorGroupMembershipResource) Delete(ctx context.Cont
 	}
 }
 
+func (r *alertDefinitionOperatorGroupMembershipResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"alertdefinition_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "GUID of the alert definition.",
+			},
+			"operatorgroup_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "GUID of the operator group.",
+			},
+			"escalationlevel": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "Escalation level (1-4).",
+			},
+		},
+	}
+}
+
 func (r *alertDefinitionOperatorGroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity alertDefinitionOperatorGroupMembershipIdentityModel
+	importID, diags := importStateID(ctx, req, &identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Split the import ID into alertdefinition_id, operatorgroup_id, and escalationlevel
-	idParts := strings.Split(req.ID, ":")
+	idParts := strings.Split(importID, ":")
 
 	// Validate the format of the import ID
 	if len(idParts) != 3 {
 		resp.Diagnostics.AddError(
 			"Invalid Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: alertdefinition_id:operatorgroup_id:escalationlevel. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: alertdefinition_id:operatorgroup_id:escalationlevel. Got: %q", importID),
 		)
 		return
 	}
@@ -201,7 +249,7 @@ func (r *alertDefinitionOperatorGroupMembershipResource) ImportState(ctx context
 	if idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		resp.Diagnostics.AddError(
 			"Invalid Import Identifier",
-			fmt.Sprintf("One or more parts of the import identifier are empty. Got: %q", req.ID),
+			fmt.Sprintf("One or more parts of the import identifier are empty. Got: %q", importID),
 		)
 		return
 	}
@@ -222,5 +270,5 @@ func (r *alertDefinitionOperatorGroupMembershipResource) ImportState(ctx context
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("escalationlevel"), escalationLevel)...)
 
 	// Set the ID attribute in the state
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importID)...)
 }
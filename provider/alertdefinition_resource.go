@@ -3,11 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -210,6 +213,13 @@ func (r *alertdefinitionResource) ValidateConfig(ctx context.Context, req resour
 					}
 				}
 			}
+
+			// Validate the alert placeholders in the message
+			if message, ok := level["message"].(types.String); ok && !message.IsNull() && !message.IsUnknown() {
+				messagePath := path.Root("escalation_levels").AtListIndex(idx).AtName("message")
+				variables := validateAlertPlaceholders(messagePath, message.ValueString(), &resp.Diagnostics)
+				warnForVariablePlaceholders(messagePath, variables, &resp.Diagnostics)
+			}
 		}
 
 	}
@@ -257,78 +267,51 @@ func (r *alertdefinitionResource) Create(ctx context.Context, req resource.Creat
 		)
 		return
 	}
-	// Update using patch with the clients escalation levels:
+	plan.AlertDefinitionGuid = types.StringValue(responseAlert.AlertDefinitionGuid)
+
+	// Update using patch with the clients escalation levels. The alert definition already exists at this point,
+	// so when a level fails the alert definition is rolled back; otherwise a retry would create a duplicate.
 	if !plan.EscalationLevels.IsNull() && !plan.EscalationLevels.IsUnknown() {
 		var userEscalationLevels []escalationLevelResourceModel
 		diags := plan.EscalationLevels.ElementsAs(ctx, &userEscalationLevels, false)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
+			r.rollbackCreate(ctx, &plan, resp)
 			return
 		}
 
-		for _, level := range userEscalationLevels {
-			payload := models.EscalationLevel{
-				AlertDefinitionGuid: responseAlert.AlertDefinitionGuid,
-				Id:                  int(level.Id.ValueInt64()),
-				EscalationMode:      level.EscalationMode.ValueString(),
-				ThresholdErrorCount: int(level.ThresholdErrorCount.ValueInt64()),
-				ThresholdMinutes:    int(level.ThresholdMinutes.ValueInt64()),
-				IsActive:            level.IsActive.ValueBool(),
-				Message: func() string {
-					if level.Message.IsNull() {
-						return ""
-					} else {
-						return level.Message.ValueString()
-					}
-				}(),
-				NumberOfReminders: int(level.NumberOfReminders.ValueInt64()),
-				ReminderDelay:     int(level.ReminderDelay.ValueInt64()),
-				IncludeTraceRoute: level.IncludeTraceRoute.ValueBool(),
-			}
-			// PATCH the escalation level (use the correct ID from the default levels or user input)
-			err := r.client.UpdateEscalationLevel(payload)
-			if err != nil {
-				resp.Diagnostics.AddError(
+		for idx, level := range userEscalationLevels {
+			if err := r.client.UpdateEscalationLevel(escalationLevelPayload(responseAlert.AlertDefinitionGuid, level)); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("escalation_levels").AtListIndex(idx),
 					"Error updating escalation level after creation",
 					fmt.Sprintf("Could not update escalation level %d: %s", level.Id.ValueInt64(), err),
 				)
-				// We do not return to stop the update if an escalation level fails to update
+				r.rollbackCreate(ctx, &plan, resp)
+				return
 			}
 		}
 	}
 
-	getEscalationLevels, err := r.client.GetEscalationLevels(responseAlert.AlertDefinitionGuid)
+	getEscalationLevels, _, err := r.client.GetEscalationLevels(responseAlert.AlertDefinitionGuid)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error fetching escalation levels",
 			fmt.Sprintf("Could not fetch escalation levels for alert definition %s: %s", responseAlert.AlertDefinitionGuid, err),
 		)
+		r.rollbackCreate(ctx, &plan, resp)
 		return
 	}
 
 	// Update plan with values returned from the API.
-	plan.AlertDefinitionGuid = types.StringValue(responseAlert.AlertDefinitionGuid)
-	// Convert []escalationLevelResourceModel to types.List
-	escalationLevelObjs := make([]escalationLevelResourceModel, len(getEscalationLevels))
-	for i, level := range getEscalationLevels {
-		escalationLevelObjs[i] = escalationLevelResourceModel{
-			Id:                  types.Int64Value(int64(level.Id)),
-			EscalationMode:      types.StringValue(level.EscalationMode),
-			ThresholdErrorCount: types.Int64Value(int64(level.ThresholdErrorCount)),
-			ThresholdMinutes:    types.Int64Value(int64(level.ThresholdMinutes)),
-			IsActive:            types.BoolValue(level.IsActive),
-			Message:             types.StringValue(level.Message),
-			NumberOfReminders:   types.Int64Value(int64(level.NumberOfReminders)),
-			ReminderDelay:       types.Int64Value(int64(level.ReminderDelay)),
-			IncludeTraceRoute:   types.BoolValue(level.IncludeTraceRoute),
-		}
-	}
-	listVal, diags := types.ListValueFrom(ctx, escalationLevelResourceModelType(), escalationLevelObjs)
+	reportEscalationLevelDrift(ctx, plan.EscalationLevels, getEscalationLevels, &resp.Diagnostics)
+	listVal, diags := escalationLevelsState(ctx, getEscalationLevels)
 	resp.Diagnostics.Append(diags...)
 	plan.EscalationLevels = listVal
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, plan.AlertDefinitionGuid)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -339,6 +322,7 @@ func (r *alertdefinitionResource) Read(ctx context.Context, req resource.ReadReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, state.AlertDefinitionGuid)...)
 
 	alertDefItem, err := r.client.GetAlertDefinition(state.AlertDefinitionGuid.ValueString())
 	if err != nil {
@@ -354,7 +338,7 @@ func (r *alertdefinitionResource) Read(ctx context.Context, req resource.ReadReq
 	state.IsActive = types.BoolValue(alertDefItem.IsActive)
 
 	// Read escalation levels.
-	escalationLevels, err := r.client.GetEscalationLevels(state.AlertDefinitionGuid.ValueString())
+	escalationLevels, _, err := r.client.GetEscalationLevels(state.AlertDefinitionGuid.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading escalation levels",
@@ -363,6 +347,16 @@ func (r *alertdefinitionResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	listVal, diags := escalationLevelsState(ctx, escalationLevels)
+	resp.Diagnostics.Append(diags...)
+	state.EscalationLevels = listVal
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// escalationLevelsState maps the escalation levels returned by the API to the escalation_levels list.
+func escalationLevelsState(ctx context.Context, escalationLevels []models.EscalationLevel) (types.List, diag.Diagnostics) {
 	var levels []escalationLevelResourceModel
 	for _, lvl := range escalationLevels {
 		levelModel := escalationLevelResourceModel{
@@ -378,12 +372,7 @@ func (r *alertdefinitionResource) Read(ctx context.Context, req resource.ReadReq
 		}
 		levels = append(levels, levelModel)
 	}
-	listVal, diags := types.ListValueFrom(ctx, escalationLevelResourceModelType(), levels)
-	resp.Diagnostics.Append(diags...)
-	state.EscalationLevels = listVal
-
-	diags = resp.State.Set(ctx, state)
-	resp.Diagnostics.Append(diags...)
+	return types.ListValueFrom(ctx, escalationLevelResourceModelType(), levels)
 }
 
 // Update handles updating an existing alertdefinition resource.
@@ -395,6 +384,16 @@ func (r *alertdefinitionResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	// escalation_levels is computed, so the plan holds the levels from the state when they are not configured.
+	// Those levels are left alone, as they may be managed with itrs-uptrends_alertdefinition_escalation_level.
+	var configEscalationLevels types.List
+	diags = req.Config.GetAttribute(ctx, path.Root("escalation_levels"), &configEscalationLevels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	manageEscalationLevels := !configEscalationLevels.IsNull()
+
 	// Update alert definition core fields.
 	updateReq := models.AlertDefinitionRequest{
 		AlertName: plan.Name.ValueString(),
@@ -409,40 +408,36 @@ func (r *alertdefinitionResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	// Update escalation levels.
+	// Update escalation levels. Every level is attempted, and the levels are read back afterwards so the state
+	// holds what the API has, also when some levels failed. The next plan then shows the levels that weren't applied.
 	var escalationLevelsSlice []escalationLevelResourceModel
-	if !plan.EscalationLevels.IsNull() && !plan.EscalationLevels.IsUnknown() {
+	if manageEscalationLevels && !plan.EscalationLevels.IsNull() && !plan.EscalationLevels.IsUnknown() {
 		diags := plan.EscalationLevels.ElementsAs(ctx, &escalationLevelsSlice, false)
 		resp.Diagnostics.Append(diags...)
 	}
-	for _, level := range escalationLevelsSlice {
-		payload := models.EscalationLevel{
-			EscalationMode:      level.EscalationMode.ValueString(),
-			ThresholdErrorCount: int(level.ThresholdErrorCount.ValueInt64()),
-			ThresholdMinutes:    int(level.ThresholdMinutes.ValueInt64()),
-			IsActive:            level.IsActive.ValueBool(),
-			Message: func() string {
-				if level.Message.IsNull() {
-					return ""
-				} else {
-					return level.Message.ValueString()
-				}
-			}(),
-			NumberOfReminders:   int(level.NumberOfReminders.ValueInt64()),
-			ReminderDelay:       int(level.ReminderDelay.ValueInt64()),
-			IncludeTraceRoute:   level.IncludeTraceRoute.ValueBool(),
-			AlertDefinitionGuid: plan.AlertDefinitionGuid.ValueString(),
-			Id:                  int(level.Id.ValueInt64()),
-		}
-		if err := r.client.UpdateEscalationLevel(payload); err != nil {
-			resp.Diagnostics.AddError(
+	var applied, failed []string
+	for idx, level := range escalationLevelsSlice {
+		if err := r.client.UpdateEscalationLevel(escalationLevelPayload(plan.AlertDefinitionGuid.ValueString(), level)); err != nil {
+			failed = append(failed, strconv.FormatInt(level.Id.ValueInt64(), 10))
+			resp.Diagnostics.AddAttributeError(
+				path.Root("escalation_levels").AtListIndex(idx),
 				"Error updating escalation level",
 				fmt.Sprintf("Could not update escalation level %d: %s", level.Id.ValueInt64(), err),
 			)
+			continue
 		}
+		applied = append(applied, strconv.FormatInt(level.Id.ValueInt64(), 10))
+	}
+	if len(failed) > 0 && len(applied) > 0 {
+		resp.Diagnostics.AddError(
+			"Escalation levels partially applied",
+			fmt.Sprintf("Escalation levels %s of alert definition %s were updated, but escalation levels %s were not. "+
+				"The state holds the current escalation levels, so the next plan shows the remaining changes.",
+				strings.Join(applied, ", "), plan.AlertDefinitionGuid.ValueString(), strings.Join(failed, ", ")),
+		)
 	}
 
-	escalationLevels, err := r.client.GetEscalationLevels(plan.AlertDefinitionGuid.ValueString())
+	escalationLevels, _, err := r.client.GetEscalationLevels(plan.AlertDefinitionGuid.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading escalation levels",
@@ -451,27 +446,16 @@ func (r *alertdefinitionResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	var levels []escalationLevelResourceModel
-	for _, lvl := range escalationLevels {
-		levelModel := escalationLevelResourceModel{
-			Id:                  types.Int64Value(int64(lvl.Id)),
-			EscalationMode:      types.StringValue(lvl.EscalationMode),
-			ThresholdErrorCount: types.Int64Value(int64(lvl.ThresholdErrorCount)),
-			ThresholdMinutes:    types.Int64Value(int64(lvl.ThresholdMinutes)),
-			IsActive:            types.BoolValue(lvl.IsActive),
-			Message:             types.StringValue(lvl.Message),
-			NumberOfReminders:   types.Int64Value(int64(lvl.NumberOfReminders)),
-			ReminderDelay:       types.Int64Value(int64(lvl.ReminderDelay)),
-			IncludeTraceRoute:   types.BoolValue(lvl.IncludeTraceRoute),
-		}
-		levels = append(levels, levelModel)
+	if manageEscalationLevels && len(failed) == 0 {
+		reportEscalationLevelDrift(ctx, plan.EscalationLevels, escalationLevels, &resp.Diagnostics)
 	}
-	listVal, diags := types.ListValueFrom(ctx, escalationLevelResourceModelType(), levels)
+	listVal, diags := escalationLevelsState(ctx, escalationLevels)
 	resp.Diagnostics.Append(diags...)
 	plan.EscalationLevels = listVal
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, plan.AlertDefinitionGuid)...)
 }
 
 // Delete removes the alertdefinition resource.
@@ -494,9 +478,13 @@ func (r *alertdefinitionResource) Delete(ctx context.Context, req resource.Delet
 	resp.State.RemoveResource(ctx)
 }
 
+func (r *alertdefinitionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = guidIdentitySchema()
+}
+
 func (r *alertdefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import AlertDefinitionGuid and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // Helper function for types.ObjectType for escalationLevelResourceModel
@@ -515,3 +503,107 @@ func escalationLevelResourceModelType() types.ObjectType {
 		},
 	}
 }
+
+// rollbackCreate deletes the alert definition that Create made before its escalation levels failed, so a retry
+// doesn't leave a duplicate behind. When the rollback fails too, the alert definition is kept in the state;
+// Terraform marks it as tainted and replaces it on the next apply.
+func (r *alertdefinitionResource) rollbackCreate(ctx context.Context, plan *alertDefinitionResourceModel, resp *resource.CreateResponse) {
+	alertDefGuid := plan.AlertDefinitionGuid.ValueString()
+	err := r.client.DeleteAlertDefinition(alertDefGuid)
+	if err == nil {
+		resp.Diagnostics.AddError(
+			"Alert definition rolled back",
+			fmt.Sprintf("Alert definition %s was deleted again because its escalation levels could not be applied. Fix the error above and apply again.", alertDefGuid),
+		)
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Error rolling back alert definition",
+		fmt.Sprintf("Could not delete alert definition %s after its escalation levels failed: %s. "+
+			"It is kept in the state as tainted and will be replaced on the next apply.", alertDefGuid, err),
+	)
+	plan.EscalationLevels = types.ListNull(escalationLevelResourceModelType())
+	if levels, _, err := r.client.GetEscalationLevels(alertDefGuid); err == nil {
+		listVal, diags := escalationLevelsState(ctx, levels)
+		resp.Diagnostics.Append(diags...)
+		plan.EscalationLevels = listVal
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, plan.AlertDefinitionGuid)...)
+}
+
+// escalationLevelPayload builds the PATCH request for one escalation level of the alert definition.
+func escalationLevelPayload(alertDefGuid string, level escalationLevelResourceModel) models.EscalationLevel {
+	return models.EscalationLevel{
+		AlertDefinitionGuid: alertDefGuid,
+		Id:                  int(level.Id.ValueInt64()),
+		EscalationMode:      level.EscalationMode.ValueString(),
+		ThresholdErrorCount: int(level.ThresholdErrorCount.ValueInt64()),
+		ThresholdMinutes:    int(level.ThresholdMinutes.ValueInt64()),
+		IsActive:            level.IsActive.ValueBool(),
+		Message: func() string {
+			if level.Message.IsNull() {
+				return ""
+			} else {
+				return level.Message.ValueString()
+			}
+		}(),
+		NumberOfReminders: int(level.NumberOfReminders.ValueInt64()),
+		ReminderDelay:     int(level.ReminderDelay.ValueInt64()),
+		IncludeTraceRoute: level.IncludeTraceRoute.ValueBool(),
+	}
+}
+
+// reportEscalationLevelDrift reports the escalation level attributes the API accepted but stored with a different
+// value than planned, with the attribute path, instead of leaving Terraform to report an inconsistent result.
+func reportEscalationLevelDrift(ctx context.Context, planned types.List, actual []models.EscalationLevel, diags *diag.Diagnostics) {
+	if planned.IsNull() || planned.IsUnknown() {
+		return
+	}
+	var plannedLevels []escalationLevelResourceModel
+	diags.Append(planned.ElementsAs(ctx, &plannedLevels, false)...)
+
+	actualLevels := make(map[int64]models.EscalationLevel, len(actual))
+	for _, level := range actual {
+		actualLevels[int64(level.Id)] = level
+	}
+
+	for idx, level := range plannedLevels {
+		levelPath := path.Root("escalation_levels").AtListIndex(idx)
+		got, ok := actualLevels[level.Id.ValueInt64()]
+		if !ok {
+			diags.AddAttributeError(
+				levelPath.AtName("id"),
+				"Escalation level not found",
+				fmt.Sprintf("The alert definition has no escalation level %d.", level.Id.ValueInt64()),
+			)
+			continue
+		}
+
+		differences := []struct {
+			name          string
+			planned, real string
+		}{
+			{"escalation_mode", level.EscalationMode.ValueString(), got.EscalationMode},
+			{"threshold_error_count", strconv.FormatInt(level.ThresholdErrorCount.ValueInt64(), 10), strconv.Itoa(got.ThresholdErrorCount)},
+			{"threshold_minutes", strconv.FormatInt(level.ThresholdMinutes.ValueInt64(), 10), strconv.Itoa(got.ThresholdMinutes)},
+			{"is_active", strconv.FormatBool(level.IsActive.ValueBool()), strconv.FormatBool(got.IsActive)},
+			{"message", level.Message.ValueString(), got.Message},
+			{"number_of_reminders", strconv.FormatInt(level.NumberOfReminders.ValueInt64(), 10), strconv.Itoa(got.NumberOfReminders)},
+			{"reminder_delay", strconv.FormatInt(level.ReminderDelay.ValueInt64(), 10), strconv.Itoa(got.ReminderDelay)},
+			{"include_trace_route", strconv.FormatBool(level.IncludeTraceRoute.ValueBool()), strconv.FormatBool(got.IncludeTraceRoute)},
+		}
+		for _, d := range differences {
+			if d.planned == d.real {
+				continue
+			}
+			diags.AddAttributeError(
+				levelPath.AtName(d.name),
+				"Escalation level not applied as planned",
+				fmt.Sprintf("Escalation level %d was updated, but the API stored %s = %q instead of the planned %q.",
+					level.Id.ValueInt64(), d.name, d.real, d.planned),
+			)
+		}
+	}
+}
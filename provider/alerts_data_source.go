@@ -0,0 +1,315 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	interfaces "github.com/itrs-group/terraform-provider-itrs-uptrends/client/interfaces"
+	models "github.com/itrs-group/terraform-provider-itrs-uptrends/client/models"
+)
+
+var (
+	_ datasource.DataSource                   = &alertsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &alertsDataSource{}
+)
+
+// NewAlertsDataSource constructs the alert history data source.
+func NewAlertsDataSource(client interfaces.IAlert, membership interfaces.IMonitorGroupMember) datasource.DataSource {
+	return &alertsDataSource{client: client, membershipClient: membership}
+}
+
+type alertsDataSource struct {
+	client           interfaces.IAlert
+	membershipClient interfaces.IMonitorGroupMember
+}
+
+type alertRecordModel struct {
+	ID               types.String `tfsdk:"id"`
+	MonitorID        types.String `tfsdk:"monitor_id"`
+	Type             types.String `tfsdk:"type"`
+	EscalationLevel  types.Int64  `tfsdk:"escalation_level"`
+	ErrorDescription types.String `tfsdk:"error_description"`
+	IncidentKey      types.String `tfsdk:"incident_key"`
+	Timestamp        types.String `tfsdk:"timestamp"`
+	Start            types.String `tfsdk:"start"`
+	End              types.String `tfsdk:"end"`
+}
+
+type alertsDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	MonitorID      types.String `tfsdk:"monitor_id"`
+	MonitorGroupID types.String `tfsdk:"monitorgroup_id"`
+	PresetPeriod   types.String `tfsdk:"preset_period"`
+	Start          types.String `tfsdk:"start"`
+	End            types.String `tfsdk:"end"`
+	Alerts         types.List   `tfsdk:"alerts"`
+	HasOpenAlert   types.Bool   `tfsdk:"has_open_alert"`
+}
+
+func (d *alertsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alerts"
+}
+
+func (d *alertsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Internal identifier for this data source instance.",
+		},
+		"monitor_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "GUID of the monitor to read the alerts of. Provide this or monitorgroup_id.",
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(
+					path.MatchRoot("monitor_id"),
+					path.MatchRoot("monitorgroup_id"),
+				),
+			},
+		},
+		"monitorgroup_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "GUID of the monitor group to read the alerts of its monitors. Provide this or monitor_id.",
+		},
+		"alerts": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "Alerts sent in the period, oldest first.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "Identifier of the alert.",
+					},
+					"monitor_id": schema.StringAttribute{
+						Computed:    true,
+						Description: "GUID of the monitor the alert is about.",
+					},
+					"type": schema.StringAttribute{
+						Computed:    true,
+						Description: "Alert type: Error when the monitor started failing, Ok when it recovered.",
+					},
+					"escalation_level": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The escalation level that sent the alert.",
+					},
+					"error_description": schema.StringAttribute{
+						Computed:    true,
+						Description: "Description of the error that caused the alert.",
+					},
+					"incident_key": schema.StringAttribute{
+						Computed:    true,
+						Description: "Key shared by the Error and Ok alerts of the same incident.",
+					},
+					"timestamp": schema.StringAttribute{
+						Computed:    true,
+						Description: "When the alert was sent.",
+					},
+					"start": schema.StringAttribute{
+						Computed:    true,
+						Description: "When the error that caused the alert started.",
+					},
+					"end": schema.StringAttribute{
+						Computed:    true,
+						Description: "When the monitor recovered, taken from the next Ok alert of the monitor. Null while the alert is open.",
+					},
+				},
+			},
+		},
+		"has_open_alert": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the monitor, or a monitor of the monitor group, has an open alert now: its latest alert in the last 90 days is an Error alert. Unlike alerts, this doesn't depend on the period. Reading it costs one API call per monitor, plus one for every further 100 alerts of that monitor.",
+		},
+	}
+	maps.Copy(attributes, timeRangeAttributes())
+
+	resp.Schema = schema.Schema{
+		Description: "Reads the alerts sent for a monitor or for the monitors of a monitor group in a period.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *alertsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data alertsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateTimeRange(data.Start, data.End, &resp.Diagnostics)
+}
+
+func (d *alertsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The alert client was not configured. This is an internal error in the provider.")
+		return
+	}
+
+	var data alertsDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeRange := timeRangeFromConfig(data.PresetPeriod, data.Start, data.End)
+
+	var alerts []models.AlertData
+	var statusCode int
+	var responseBody string
+	var err error
+	if !data.MonitorID.IsNull() {
+		data.ID = types.StringValue("monitor:" + data.MonitorID.ValueString())
+		alerts, statusCode, responseBody, err = d.client.GetMonitorAlerts(data.MonitorID.ValueString(), timeRange)
+	} else {
+		data.ID = types.StringValue("monitorgroup:" + data.MonitorGroupID.ValueString())
+		alerts, statusCode, responseBody, err = d.client.GetMonitorGroupAlerts(data.MonitorGroupID.ValueString(), timeRange)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing alerts", err.Error())
+		return
+	}
+	if statusCode >= 300 {
+		resp.Diagnostics.AddError(
+			"Failed to list alerts",
+			fmt.Sprintf("HTTP status code: %d with response body %v", statusCode, responseBody),
+		)
+		return
+	}
+
+	var monitorGuids []string
+	if !data.MonitorID.IsNull() {
+		monitorGuids = []string{data.MonitorID.ValueString()}
+	} else {
+		memberships, err := d.membershipClient.GetGroupMemberships(data.MonitorGroupID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading monitor group memberships", err.Error())
+			return
+		}
+		for _, m := range memberships {
+			monitorGuids = append(monitorGuids, m.MonitorGuid)
+		}
+	}
+	hasOpenAlert, ok := d.hasOpenAlert(monitorGuids, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	records := alertRecords(alerts)
+
+	alertsVal, diags := types.ListValueFrom(ctx, alertRecordModelType(), records)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Alerts = alertsVal
+	data.HasOpenAlert = types.BoolValue(hasOpenAlert)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// hasOpenAlert reports whether the latest alert of one of the monitors is an Error alert. The boolean result
+// is false when an error was added to diags.
+func (d *alertsDataSource) hasOpenAlert(monitorGuids []string, diags *diag.Diagnostics) (bool, bool) {
+	for _, monitorGuid := range monitorGuids {
+		latest, statusCode, responseBody, err := d.client.GetLatestMonitorAlert(monitorGuid)
+		if err != nil {
+			diags.AddError("Error reading the latest alert", fmt.Sprintf("Could not read the latest alert of monitor %s: %s", monitorGuid, err))
+			return false, false
+		}
+		if statusCode >= 300 {
+			diags.AddError(
+				"Failed to read the latest alert",
+				fmt.Sprintf("HTTP status code: %d with response body %v", statusCode, responseBody),
+			)
+			return false, false
+		}
+		if latest != nil && latest.Attributes.AlertType == "Error" {
+			return true, true
+		}
+	}
+	return false, true
+}
+
+// alertRecords sorts the alerts oldest first and pairs each Error alert with the next Ok alert of the
+// same monitor, which sets its end.
+func alertRecords(alerts []models.AlertData) []alertRecordModel {
+	sorted := make([]models.AlertData, len(alerts))
+	copy(sorted, alerts)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		left, leftOk := parseAPITime(sorted[i].Attributes.Timestamp)
+		right, rightOk := parseAPITime(sorted[j].Attributes.Timestamp)
+		if leftOk && rightOk {
+			return left.Before(right)
+		}
+		return sorted[i].Attributes.Timestamp < sorted[j].Attributes.Timestamp
+	})
+
+	records := make([]alertRecordModel, 0, len(sorted))
+	openByMonitor := map[string][]int{}
+	for _, alert := range sorted {
+		attributes := alert.Attributes
+		start := attributes.FirstError
+		if start == "" {
+			start = attributes.Timestamp
+		}
+
+		record := alertRecordModel{
+			ID:               types.StringValue(alert.Id),
+			MonitorID:        types.StringValue(attributes.MonitorGuid),
+			Type:             types.StringValue(attributes.AlertType),
+			EscalationLevel:  types.Int64Value(int64(attributes.EscalationLevel)),
+			ErrorDescription: stringValueOrNull(attributes.ErrorDescription),
+			IncidentKey:      stringValueOrNull(attributes.IncidentKey),
+			Timestamp:        types.StringValue(attributes.Timestamp),
+			Start:            types.StringValue(start),
+			End:              types.StringNull(),
+		}
+
+		if attributes.AlertType == "Ok" {
+			record.End = types.StringValue(attributes.Timestamp)
+			for _, idx := range openByMonitor[attributes.MonitorGuid] {
+				records[idx].End = types.StringValue(attributes.Timestamp)
+			}
+			delete(openByMonitor, attributes.MonitorGuid)
+		} else {
+			openByMonitor[attributes.MonitorGuid] = append(openByMonitor[attributes.MonitorGuid], len(records))
+		}
+		records = append(records, record)
+	}
+
+	return records
+}
+
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+func alertRecordModelType() attr.Type {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":                types.StringType,
+			"monitor_id":        types.StringType,
+			"type":              types.StringType,
+			"escalation_level":  types.Int64Type,
+			"error_description": types.StringType,
+			"incident_key":      types.StringType,
+			"timestamp":         types.StringType,
+			"start":             types.StringType,
+			"end":               types.StringType,
+		},
+	}
+}
@@ -3,6 +3,9 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/mail"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -10,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -22,13 +26,17 @@ import (
 )
 
 var _ resource.Resource = &escalationLevelIntegrationResource{}
+var _ resource.ResourceWithModifyPlan = &escalationLevelIntegrationResource{}
 
 type escalationLevelIntegrationResource struct {
-	client interfaces.IEscalationLevelIntegration
+	client            interfaces.IEscalationLevelIntegration
+	integrationClient interfaces.IIntegration
 }
 
-func NewEscalationLevelIntegrationResource(client interfaces.IEscalationLevelIntegration) resource.Resource {
-	return &escalationLevelIntegrationResource{client: client}
+// NewEscalationLevelIntegrationResource creates the resource. The integration client reads the type and
+// declared variables of the integration, so the configuration can be validated at plan time.
+func NewEscalationLevelIntegrationResource(client interfaces.IEscalationLevelIntegration, integrationClient interfaces.IIntegration) resource.Resource {
+	return &escalationLevelIntegrationResource{client: client, integrationClient: integrationClient}
 }
 
 type escalationLevelIntegrationModel struct {
@@ -48,6 +56,25 @@ type escalationLevelIntegrationModel struct {
 	IntegrationServices       types.List   `tfsdk:"integration_services"`
 }
 
+// escalationLevelIntegrationIdentityModel is the resource identity of itrs-uptrends_escalation_level_integration.
+type escalationLevelIntegrationIdentityModel struct {
+	AlertDefinitionID types.String `tfsdk:"alertdefinition_id"`
+	EscalationLevelID types.Int64  `tfsdk:"escalation_level_id"`
+	IntegrationGuid   types.String `tfsdk:"integration_guid"`
+}
+
+func (i escalationLevelIntegrationIdentityModel) importID() string {
+	return fmt.Sprintf("%s:%d:%s", i.AlertDefinitionID.ValueString(), i.EscalationLevelID.ValueInt64(), i.IntegrationGuid.ValueString())
+}
+
+func (m escalationLevelIntegrationModel) identity() escalationLevelIntegrationIdentityModel {
+	return escalationLevelIntegrationIdentityModel{
+		AlertDefinitionID: m.AlertDefinitionID,
+		EscalationLevelID: m.EscalationLevelID,
+		IntegrationGuid:   m.IntegrationGuid,
+	}
+}
+
 type statusHubServiceEntryModel struct {
 	MonitorGuid            types.String `tfsdk:"monitor_guid"`
 	IntegrationServiceGuid types.String `tfsdk:"integration_service_guid"`
@@ -227,6 +254,7 @@ func (r *escalationLevelIntegrationResource) Create(ctx context.Context, req res
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, plan.identity())...)
 }
 
 func (r *escalationLevelIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -236,6 +264,7 @@ func (r *escalationLevelIntegrationResource) Read(ctx context.Context, req resou
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, state.identity())...)
 
 	integration := r.getIntegration(
 		state.AlertDefinitionID.ValueString(),
@@ -313,6 +342,7 @@ func (r *escalationLevelIntegrationResource) Update(ctx context.Context, req res
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, plan.identity())...)
 }
 
 func (r *escalationLevelIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -336,12 +366,38 @@ func (r *escalationLevelIntegrationResource) Delete(ctx context.Context, req res
 	}
 }
 
+func (r *escalationLevelIntegrationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"alertdefinition_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "GUID of the alert definition.",
+			},
+			"escalation_level_id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "Escalation level ID (1-4).",
+			},
+			"integration_guid": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "GUID of the integration.",
+			},
+		},
+	}
+}
+
 func (r *escalationLevelIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ":")
+	var identity escalationLevelIntegrationIdentityModel
+	importID, diags := importStateID(ctx, req, &identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(importID, ":")
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected format: alertdefinition_id:escalation_level_id:integration_guid. Got: %q", req.ID),
+			fmt.Sprintf("Expected format: alertdefinition_id:escalation_level_id:integration_guid. Got: %q", importID),
 		)
 		return
 	}
@@ -355,12 +411,188 @@ func (r *escalationLevelIntegrationResource) ImportState(ctx context.Context, re
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("alertdefinition_id"), idParts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("escalation_level_id"), escalationLevelId)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("integration_guid"), idParts[2])...)
 }
 
+// ModifyPlan validates the configuration against the integration it refers to: variable_values must only set
+// variables the integration declares and must set its required variables, and extra_email_addresses and
+// status_hub_service_list are only accepted by the integration types that support them.
+func (r *escalationLevelIntegrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// The resource is being destroyed.
+		return
+	}
+	if r.integrationClient == nil {
+		return
+	}
+
+	var config escalationLevelIntegrationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.IntegrationGuid.IsNull() || config.IntegrationGuid.IsUnknown() {
+		return
+	}
+
+	integrationGuid := config.IntegrationGuid.ValueString()
+	integration, statusCode, responseBody, err := r.integrationClient.GetIntegration(integrationGuid)
+	if statusCode == http.StatusNotFound {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("integration_guid"),
+			"Integration not found",
+			fmt.Sprintf("No integration found with GUID %q.", integrationGuid),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("integration_guid"),
+			"Error reading integration",
+			fmt.Sprintf("%v - %s", err, responseBody),
+		)
+		return
+	}
+
+	r.validateVariableValues(ctx, config, integration, resp)
+	r.validateTypeSpecificFields(ctx, config, integration, resp)
+}
+
+// validateVariableValues checks variable_values against the variables declared by the integration.
+func (r *escalationLevelIntegrationResource) validateVariableValues(ctx context.Context, config escalationLevelIntegrationModel, integration *models.IntegrationResponse, resp *resource.ModifyPlanResponse) {
+	if config.VariableValues.IsUnknown() {
+		return
+	}
+
+	values := map[string]types.String{}
+	if !config.VariableValues.IsNull() {
+		resp.Diagnostics.Append(config.VariableValues.ElementsAs(ctx, &values, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	declared := make(map[string]bool, len(integration.Variables))
+	names := make([]string, 0, len(integration.Variables))
+	for _, v := range integration.Variables {
+		declared[v.Name] = true
+		names = append(names, v.Name)
+	}
+	sort.Strings(names)
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if declared[key] {
+			continue
+		}
+		detail := fmt.Sprintf("Integration %q (%s) doesn't declare a variable named %q.", integration.Name, integration.Type, key)
+		if len(names) == 0 {
+			detail += " The integration has no variables."
+		} else {
+			detail += fmt.Sprintf(" Declared variables: %s.", strings.Join(names, ", "))
+		}
+		resp.Diagnostics.AddAttributeError(path.Root("variable_values").AtMapKey(key), "Unknown integration variable", detail)
+	}
+
+	for _, v := range integration.Variables {
+		if !v.IsRequired {
+			continue
+		}
+		if value, ok := values[v.Name]; ok && (value.IsUnknown() || value.ValueString() != "") {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("variable_values"),
+			"Missing required integration variable",
+			fmt.Sprintf("Integration %q (%s) requires a value for variable %q.", integration.Name, integration.Type, v.Name),
+		)
+	}
+}
+
+// validateTypeSpecificFields checks the attributes that only some integration types accept.
+func (r *escalationLevelIntegrationResource) validateTypeSpecificFields(ctx context.Context, config escalationLevelIntegrationModel, integration *models.IntegrationResponse, resp *resource.ModifyPlanResponse) {
+	if !config.ExtraEmailAddresses.IsNull() && !config.ExtraEmailAddresses.IsUnknown() {
+		if integration.Type != "Email" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("extra_email_addresses"),
+				"Attribute not supported by integration type",
+				fmt.Sprintf("extra_email_addresses is only supported by Email integrations. Integration %q is of type %q.", integration.Name, integration.Type),
+			)
+		} else {
+			var emails []types.String
+			resp.Diagnostics.Append(config.ExtraEmailAddresses.ElementsAs(ctx, &emails, false)...)
+			for idx, email := range emails {
+				if email.IsNull() || email.IsUnknown() {
+					continue
+				}
+				if _, err := mail.ParseAddress(email.ValueString()); err != nil {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("extra_email_addresses").AtListIndex(idx),
+						"Invalid email address",
+						fmt.Sprintf("%q is not a valid email address: %s", email.ValueString(), err),
+					)
+				}
+			}
+		}
+	}
+
+	if !config.StatusHubServiceList.IsNull() && !config.StatusHubServiceList.IsUnknown() {
+		if integration.Type != "Statushub" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("status_hub_service_list"),
+				"Attribute not supported by integration type",
+				fmt.Sprintf("status_hub_service_list is only supported by Statushub integrations. Integration %q is of type %q.", integration.Name, integration.Type),
+			)
+		} else {
+			services := make(map[string]bool, len(integration.IntegrationServices))
+			serviceNames := make([]string, 0, len(integration.IntegrationServices))
+			for _, service := range integration.IntegrationServices {
+				services[service.IntegrationServiceGuid] = true
+				serviceNames = append(serviceNames, fmt.Sprintf("%s (%s)", service.IntegrationServiceGuid, service.Name))
+			}
+
+			var entries []statusHubServiceEntryModel
+			resp.Diagnostics.Append(config.StatusHubServiceList.ElementsAs(ctx, &entries, false)...)
+			for idx, entry := range entries {
+				if entry.IntegrationServiceGuid.IsNull() || entry.IntegrationServiceGuid.IsUnknown() {
+					continue
+				}
+				if services[entry.IntegrationServiceGuid.ValueString()] {
+					continue
+				}
+				detail := fmt.Sprintf("Integration %q has no service with GUID %q.", integration.Name, entry.IntegrationServiceGuid.ValueString())
+				if len(serviceNames) > 0 {
+					detail += fmt.Sprintf(" Available services: %s.", strings.Join(serviceNames, ", "))
+				}
+				resp.Diagnostics.AddAttributeError(
+					path.Root("status_hub_service_list").AtListIndex(idx).AtName("integration_service_guid"),
+					"Unknown integration service",
+					detail,
+				)
+			}
+		}
+	}
+
+	if config.SendOkAlerts.ValueBool() && (integration.Type == "Phone" || integration.Type == "GenericWebhook") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("send_ok_alerts_wo"),
+			"Attribute not supported by integration type",
+			fmt.Sprintf("send_ok_alerts_wo must be false for %s integrations.", integration.Type),
+		)
+	}
+	if config.SendReminderAlerts.ValueBool() && integration.Type == "GenericWebhook" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("send_reminder_alerts_wo"),
+			"Attribute not supported by integration type",
+			fmt.Sprintf("send_reminder_alerts_wo must be false for %s integrations.", integration.Type),
+		)
+	}
+}
+
 // buildPayload constructs the API request from the Terraform plan.
 // *Specified flags are only set to true when the user provides the corresponding field,
 // because some integration types reject these fields as NotAvailable.
@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	interfaces "github.com/itrs-group/terraform-provider-itrs-uptrends/client/interfaces"
+	models "github.com/itrs-group/terraform-provider-itrs-uptrends/client/models"
+	converters "github.com/itrs-group/terraform-provider-itrs-uptrends/converters/integration"
+	tfsdkmodels "github.com/itrs-group/terraform-provider-itrs-uptrends/provider/models"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &integrationDataSource{}
+)
+
+// NewIntegrationDataSource constructs the integration data source.
+func NewIntegrationDataSource(client interfaces.IIntegration) datasource.DataSource {
+	return &integrationDataSource{client: client}
+}
+
+type integrationDataSource struct {
+	client interfaces.IIntegration
+}
+
+// Metadata returns the data source type name.
+func (d *integrationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration"
+}
+
+// Schema defines the schema for the data source.
+func (d *integrationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an integration by id or name. Secrets such as webhook URLs and keys are never returned.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Integration GUID. Provide this or name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Integration name. Provide this or id.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The integration type.",
+				Computed:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "The URL the webhook calls. Only for GenericWebhook integrations.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"http_method": schema.StringAttribute{
+				Description: "The HTTP method of the webhook call. Only for GenericWebhook integrations.",
+				Computed:    true,
+			},
+			"body_template": schema.StringAttribute{
+				Description: "The custom body template of the webhook call. Only for GenericWebhook integrations.",
+				Computed:    true,
+			},
+			"hub_name": schema.StringAttribute{
+				Description: "The name of the Statushub hub. Only for Statushub integrations.",
+				Computed:    true,
+			},
+			"variables": schema.ListNestedAttribute{
+				Description: "Variables of the integration.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the variable.",
+							Computed:    true,
+						},
+						"default_value": schema.StringAttribute{
+							Description: "The value used when an escalation level doesn't set the variable.",
+							Computed:    true,
+						},
+						"required": schema.BoolAttribute{
+							Description: "Whether escalation levels must set the variable.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"services": schema.ListNestedAttribute{
+				Description: "The Statushub services of the hub.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The GUID of the integration service.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the integration service.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *integrationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The integration client was not configured. This is an internal error in the provider.")
+		return
+	}
+
+	var data tfsdkmodels.IntegrationDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idProvided := !data.ID.IsNull() && data.ID.ValueString() != ""
+	nameProvided := !data.Name.IsNull() && data.Name.ValueString() != ""
+
+	switch {
+	case idProvided && nameProvided:
+		resp.Diagnostics.AddError("Invalid configuration", "Provide only one of id or name to look up an integration.")
+		return
+	case !idProvided && !nameProvided:
+		resp.Diagnostics.AddError("Invalid configuration", "Provide either id or name to look up an integration.")
+		return
+	}
+
+	var integration *models.IntegrationResponse
+	if idProvided {
+		result, _, responseBody, err := d.client.GetIntegration(data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading integration", fmt.Sprintf("Error: %v. Message: %s", err, responseBody))
+			return
+		}
+		integration = result
+	} else {
+		integrations, statusCode, responseBody, err := d.client.GetIntegrations()
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing integrations", err.Error())
+			return
+		}
+		if statusCode >= 300 {
+			resp.Diagnostics.AddError(
+				"Failed to list integrations",
+				fmt.Sprintf("HTTP status code: %d with response body %v", statusCode, responseBody),
+			)
+			return
+		}
+
+		name := data.Name.ValueString()
+		for idx := range integrations {
+			if strings.EqualFold(integrations[idx].Name, name) {
+				if integration != nil {
+					resp.Diagnostics.AddError("Integration not unique", fmt.Sprintf("More than one integration found with name %q", name))
+					return
+				}
+				integration = &integrations[idx]
+			}
+		}
+
+		if integration == nil {
+			resp.Diagnostics.AddError("Integration not found", fmt.Sprintf("No integration found with name %q", name))
+			return
+		}
+	}
+
+	data, diags = converters.UpdateStateConversionDataSource(ctx, integration)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
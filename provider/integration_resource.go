@@ -0,0 +1,391 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	interfaces "github.com/itrs-group/terraform-provider-itrs-uptrends/client/interfaces"
+	"github.com/itrs-group/terraform-provider-itrs-uptrends/constants"
+	converters "github.com/itrs-group/terraform-provider-itrs-uptrends/converters/integration"
+	"github.com/itrs-group/terraform-provider-itrs-uptrends/helpers"
+	tfsdkmodels "github.com/itrs-group/terraform-provider-itrs-uptrends/provider/models"
+)
+
+var _ resource.ResourceWithValidateConfig = &integrationResource{}
+var _ resource.ResourceWithIdentity = &integrationResource{}
+var _ resource.ResourceWithImportState = &integrationResource{}
+
+// integrationResource implements the Terraform resource for integrations.
+type integrationResource struct {
+	client interfaces.IIntegration
+}
+
+// NewIntegrationResource returns a new integration resource.
+func NewIntegrationResource(client interfaces.IIntegration) resource.Resource {
+	return &integrationResource{
+		client: client,
+	}
+}
+
+// Metadata sets the resource type name.
+func (r *integrationResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "itrs-uptrends_integration"
+}
+
+// Schema returns the Terraform schema for this resource.
+func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Description: "Manages an integration: the webhook, Slack channel, Microsoft Teams channel, PagerDuty service or Statushub hub that escalation levels send their alerts to.",
+		Attributes: map[string]rschema.Attribute{
+			"id": rschema.StringAttribute{
+				Computed:    true,
+				Description: "The GUID of the integration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": rschema.StringAttribute{
+				Required:    true,
+				Description: "The name of the integration.",
+			},
+			"type": rschema.StringAttribute{
+				Required:    true,
+				Description: "The integration type. Changing this forces a new resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(constants.IntegrationTypes...),
+				},
+			},
+			"url": rschema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The URL the webhook calls. Required for GenericWebhook integrations. The API returns it, so it is stored in state.",
+			},
+			"http_method": rschema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The HTTP method of the webhook call. Only for GenericWebhook integrations.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("GET", "POST", "PUT", "PATCH"),
+				},
+			},
+			"body_template": rschema.StringAttribute{
+				Optional:    true,
+				Description: "The custom body template of the webhook call, which can use alert placeholders such as {{@alert.type}}. Only for GenericWebhook integrations.",
+			},
+			"webhook_url_wo": rschema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "The incoming webhook URL of the Slack or Microsoft Teams channel. Write-only field, not stored in state. Required when the integration is created.",
+			},
+			"webhook_url_wo_version": rschema.Int64Attribute{
+				Optional:    true,
+				Description: "Version of the webhook URL. Increment this value to send a new webhook_url_wo.",
+			},
+			"integration_key_wo": rschema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "The PagerDuty integration key. Write-only field, not stored in state. Required when the integration is created.",
+			},
+			"integration_key_wo_version": rschema.Int64Attribute{
+				Optional:    true,
+				Description: "Version of the integration key. Increment this value to send a new integration_key_wo.",
+			},
+			"api_key_wo": rschema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "The Statushub API key. Write-only field, not stored in state. Required when the integration is created.",
+			},
+			"api_key_wo_version": rschema.Int64Attribute{
+				Optional:    true,
+				Description: "Version of the API key. Increment this value to send a new api_key_wo.",
+			},
+			"hub_name": rschema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the Statushub hub. Required for Statushub integrations.",
+			},
+			"variables": rschema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Variables of the integration, which escalation levels can set through variable_values.",
+				NestedObject: rschema.NestedAttributeObject{
+					Attributes: map[string]rschema.Attribute{
+						"name": rschema.StringAttribute{
+							Required:    true,
+							Description: "The name of the variable.",
+						},
+						"default_value": rschema.StringAttribute{
+							Optional:    true,
+							Description: "The value used when an escalation level doesn't set the variable.",
+						},
+						"required": rschema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+							Description: "Whether escalation levels must set the variable. Defaults to false.",
+						},
+					},
+				},
+			},
+			"services": rschema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The Statushub services of the hub, which escalation levels map monitors to through status_hub_service_list.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: rschema.NestedAttributeObject{
+					Attributes: map[string]rschema.Attribute{
+						"id": rschema.StringAttribute{
+							Computed:    true,
+							Description: "The GUID of the integration service.",
+						},
+						"name": rschema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the integration service.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that only the attributes of the integration type are set, that variable names are unique
+// and that the body template only uses alert placeholders and declared variables.
+func (r *integrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config tfsdkmodels.IntegrationResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || config.Type.IsUnknown() {
+		return
+	}
+
+	integrationType := config.Type.ValueString()
+
+	required := helpers.GetRequiredAttributes(integrationType, constants.IntegrationResourceAttributes)
+	if err := helpers.ValidateRequiredAttributes("itrs-uptrends_integration", integrationType, config, required); err != nil {
+		resp.Diagnostics.AddError("Invalid configuration", err.Error())
+	}
+
+	allowed := helpers.GetAllowedAttributes(integrationType, constants.IntegrationResourceAttributes)
+	if err := helpers.ValidateAllowedAttributes("itrs-uptrends_integration", integrationType, config, allowed); err != nil {
+		resp.Diagnostics.AddError("Invalid configuration", err.Error())
+	}
+
+	var variables []tfsdkmodels.IntegrationVariableModel
+	if !config.Variables.IsNull() && !config.Variables.IsUnknown() {
+		resp.Diagnostics.Append(config.Variables.ElementsAs(ctx, &variables, false)...)
+	}
+	seen := map[string]int{}
+	for idx, v := range variables {
+		if v.Name.IsNull() || v.Name.IsUnknown() {
+			continue
+		}
+		if prevIdx, exists := seen[v.Name.ValueString()]; exists {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("variables").AtListIndex(idx).AtName("name"),
+				"Duplicate integration variable",
+				fmt.Sprintf("Variable %q is declared at indices %d and %d. Each variable must have a unique name.", v.Name.ValueString(), prevIdx, idx),
+			)
+			continue
+		}
+		seen[v.Name.ValueString()] = idx
+	}
+
+	// Placeholders in the body template are alert placeholders or variables of the integration.
+	if config.BodyTemplate.IsNull() || config.BodyTemplate.IsUnknown() {
+		return
+	}
+	bodyVariables := validateAlertPlaceholders(path.Root("body_template"), config.BodyTemplate.ValueString(), &resp.Diagnostics)
+	if config.Variables.IsUnknown() || len(seen) < len(variables) {
+		// Variable names that aren't known yet can't be checked.
+		return
+	}
+	for _, name := range bodyVariables {
+		if _, declared := seen[name]; declared {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("body_template"),
+			"Undeclared integration variable",
+			fmt.Sprintf("Placeholder {{%s}} is neither an alert placeholder nor a variable declared in variables.", name),
+		)
+	}
+}
+
+// Create creates the integration.
+func (r *integrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var config tfsdkmodels.IntegrationResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Secrets are write-only, so they can only be required when the integration is created.
+	integrationType := config.Type.ValueString()
+	required := helpers.GetRequiredAttributes(integrationType, constants.IntegrationResourceAttributesCreate)
+	if err := helpers.ValidateRequiredAttributes("itrs-uptrends_integration", integrationType, config, required); err != nil {
+		resp.Diagnostics.AddError("Invalid configuration", err.Error())
+		return
+	}
+
+	payload, diags := converters.PayloadConversion(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, statusCode, responseBody, err := r.client.CreateIntegration(payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating integration", fmt.Sprintf("%s: %s", err.Error(), responseBody))
+		return
+	}
+	if statusCode >= 300 {
+		resp.Diagnostics.AddError(
+			"Failed to create integration",
+			fmt.Sprintf("HTTP status code: %d with response body %v", statusCode, responseBody),
+		)
+		return
+	}
+
+	state, diags := converters.UpdateStateConversion(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	state.WebhookUrlVersion = config.WebhookUrlVersion
+	state.IntegrationKeyVersion = config.IntegrationKeyVersion
+	state.ApiKeyVersion = config.ApiKeyVersion
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, state.ID)...)
+}
+
+// Read refreshes the integration from the API.
+func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state tfsdkmodels.IntegrationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, state.ID)...)
+
+	result, statusCode, responseBody, err := r.client.GetIntegration(state.ID.ValueString())
+	if statusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading integration", fmt.Sprintf("%s: %s", err.Error(), responseBody))
+		return
+	}
+
+	refreshed, diags := converters.UpdateStateConversion(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	refreshed.WebhookUrlVersion = state.WebhookUrlVersion
+	refreshed.IntegrationKeyVersion = state.IntegrationKeyVersion
+	refreshed.ApiKeyVersion = state.ApiKeyVersion
+
+	diags = resp.State.Set(ctx, &refreshed)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the integration.
+func (r *integrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var config tfsdkmodels.IntegrationResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state tfsdkmodels.IntegrationResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integrationID := state.ID.ValueString()
+
+	payload, diags := converters.PayloadConversion(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statusCode, responseBody, err := r.client.UpdateIntegration(integrationID, payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating integration", fmt.Sprintf("%s: %s", err.Error(), responseBody))
+		return
+	}
+	if statusCode >= 300 {
+		resp.Diagnostics.AddError(
+			"Failed to update integration",
+			fmt.Sprintf("HTTP status code: %d with response body %v", statusCode, responseBody),
+		)
+		return
+	}
+
+	// Re-read from the server to get the latest data
+	result, _, responseBody, err := r.client.GetIntegration(integrationID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading integration", fmt.Sprintf("%s: %s", err.Error(), responseBody))
+		return
+	}
+
+	state, diags = converters.UpdateStateConversion(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	state.WebhookUrlVersion = config.WebhookUrlVersion
+	state.IntegrationKeyVersion = config.IntegrationKeyVersion
+	state.ApiKeyVersion = config.ApiKeyVersion
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, state.ID)...)
+}
+
+// Delete deletes the integration.
+func (r *integrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state tfsdkmodels.IntegrationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statusCode, responseBody, err := r.client.DeleteIntegration(state.ID.ValueString())
+	if statusCode == http.StatusNotFound {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting integration", fmt.Sprintf("%s: %s", err.Error(), responseBody))
+		return
+	}
+}
+
+func (r *integrationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = guidIdentitySchema()
+}
+
+func (r *integrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
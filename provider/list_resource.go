@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// newGuidListResult creates the list result for one object, identified by its GUID.
+// The resource data is filled in by the caller when req.IncludeResource is set.
+func newGuidListResult(ctx context.Context, req list.ListRequest, guid, displayName string) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = displayName
+	result.Diagnostics.Append(result.Identity.Set(ctx, guidIdentityModel{ID: types.StringValue(guid)})...)
+	return result
+}
+
+// streamListResults sends results to Terraform, stopping at the limit of the request.
+func streamListResults(req list.ListRequest, results []list.ListResult, stream *list.ListResultsStream) {
+	if req.Limit > 0 && int64(len(results)) > req.Limit {
+		results = results[:req.Limit]
+	}
+	stream.Results = slices.Values(results)
+}
+
+// compileNameRegex compiles the optional name_regex filter of a list resource.
+func compileNameRegex(nameRegex types.String) (*regexp.Regexp, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if nameRegex.IsNull() {
+		return nil, diags
+	}
+	re, err := regexp.Compile(nameRegex.ValueString())
+	if err != nil {
+		diags.AddError("Invalid name_regex", err.Error())
+	}
+	return re, diags
+}
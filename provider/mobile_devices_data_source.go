@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/itrs-group/terraform-provider-itrs-uptrends/constants"
+)
+
+var _ datasource.DataSource = &mobileDevicesDataSource{}
+
+// NewMobileDevicesDataSource constructs the mobile devices data source.
+// The catalog is built into the provider, so no client is needed.
+func NewMobileDevicesDataSource() datasource.DataSource {
+	return &mobileDevicesDataSource{}
+}
+
+type mobileDevicesDataSource struct{}
+
+type mobileDevicesDataSourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Names   types.List   `tfsdk:"names"`
+	Devices types.List   `tfsdk:"devices"`
+}
+
+type mobileDeviceModel struct {
+	Name       types.String `tfsdk:"name"`
+	Width      types.Int64  `tfsdk:"width"`
+	Height     types.Int64  `tfsdk:"height"`
+	PixelRatio types.Int64  `tfsdk:"pixel_ratio"`
+}
+
+func (d *mobileDevicesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mobile_devices"
+}
+
+func (d *mobileDevicesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Internal identifier for this data source instance.",
+			},
+			"names": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Names of the supported mobile devices, for use in browser_window_dimensions.mobile_device.",
+			},
+			"devices": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Supported mobile devices with their native dimensions.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name":        schema.StringAttribute{Computed: true},
+						"width":       schema.Int64Attribute{Computed: true},
+						"height":      schema.Int64Attribute{Computed: true},
+						"pixel_ratio": schema.Int64Attribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *mobileDevicesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	names := make([]string, 0, len(constants.MobileDevices))
+	devices := make([]mobileDeviceModel, 0, len(constants.MobileDevices))
+	for _, device := range constants.MobileDevices {
+		names = append(names, device.Name)
+		devices = append(devices, mobileDeviceModel{
+			Name:       types.StringValue(device.Name),
+			Width:      types.Int64Value(device.Width),
+			Height:     types.Int64Value(device.Height),
+			PixelRatio: types.Int64Value(device.PixelRatio),
+		})
+	}
+
+	namesVal, diag := types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	devicesVal, diag := types.ListValueFrom(ctx, mobileDeviceModelType(), devices)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := mobileDevicesDataSourceModel{
+		ID:      types.StringValue("mobile_devices_data_source"),
+		Names:   namesVal,
+		Devices: devicesVal,
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func mobileDeviceModelType() attr.Type {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name":        types.StringType,
+			"width":       types.Int64Type,
+			"height":      types.Int64Type,
+			"pixel_ratio": types.Int64Type,
+		},
+	}
+}
@@ -0,0 +1,61 @@
+package tfsdkmodels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IntegrationResourceModel is the model of itrs-uptrends_integration. All attributes are framework types, so the
+// model is also used to validate the allowed and required attributes per integration type.
+type IntegrationResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Type                  types.String `tfsdk:"type"`
+	Url                   types.String `tfsdk:"url"`
+	HttpMethod            types.String `tfsdk:"http_method"`
+	BodyTemplate          types.String `tfsdk:"body_template"`
+	WebhookUrl            types.String `tfsdk:"webhook_url_wo"`
+	WebhookUrlVersion     types.Int64  `tfsdk:"webhook_url_wo_version"`
+	IntegrationKey        types.String `tfsdk:"integration_key_wo"`
+	IntegrationKeyVersion types.Int64  `tfsdk:"integration_key_wo_version"`
+	ApiKey                types.String `tfsdk:"api_key_wo"`
+	ApiKeyVersion         types.Int64  `tfsdk:"api_key_wo_version"`
+	HubName               types.String `tfsdk:"hub_name"`
+	Variables             types.List   `tfsdk:"variables"`
+	Services              types.List   `tfsdk:"services"`
+}
+
+type IntegrationVariableModel struct {
+	Name         types.String `tfsdk:"name"`
+	DefaultValue types.String `tfsdk:"default_value"`
+	Required     types.Bool   `tfsdk:"required"`
+}
+
+type IntegrationServiceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+var IntegrationVariableAttrTypes = map[string]attr.Type{
+	"name":          types.StringType,
+	"default_value": types.StringType,
+	"required":      types.BoolType,
+}
+
+var IntegrationServiceAttrTypes = map[string]attr.Type{
+	"id":   types.StringType,
+	"name": types.StringType,
+}
+
+// IntegrationDataSourceModel is the model of the itrs-uptrends_integration data source.
+type IntegrationDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Type         types.String `tfsdk:"type"`
+	Url          types.String `tfsdk:"url"`
+	HttpMethod   types.String `tfsdk:"http_method"`
+	BodyTemplate types.String `tfsdk:"body_template"`
+	HubName      types.String `tfsdk:"hub_name"`
+	Variables    types.List   `tfsdk:"variables"`
+	Services     types.List   `tfsdk:"services"`
+}
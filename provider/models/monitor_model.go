@@ -18,6 +18,7 @@ type MonitorModel struct {
 	Notes                               types.String                  `tfsdk:"notes"`
 	CustomMetrics                       *[]CustomMetricModel          `tfsdk:"custom_metrics"`
 	CustomFields                        *[]CustomFieldModel           `tfsdk:"custom_fields"`
+	CustomFieldsAll                     *[]CustomFieldModel           `tfsdk:"custom_fields_all"`
 	SelectedCheckpoints                 *SelectedCheckpointsModel     `tfsdk:"selected_checkpoints"`
 	UsePrimaryCheckpointsOnly           types.Bool                    `tfsdk:"use_primary_checkpoints_only"`
 	SelfServiceTransactionScript        types.String                  `tfsdk:"self_service_transaction_script"`
@@ -28,6 +29,7 @@ type MonitorModel struct {
 	RequestHeaders                      *[]RequestHeaderModel         `tfsdk:"request_headers"`
 	UserAgent                           types.String                  `tfsdk:"user_agent"`
 	Username                            types.String                  `tfsdk:"username"`
+	CredentialVaultItemId               types.String                  `tfsdk:"credential_vault_item_id"`
 	Password                            types.String                  `tfsdk:"password_wo"`
 	PasswordVersion                     types.Int64                   `tfsdk:"password_wo_version"`
 	NameForPhoneAlerts                  types.String                  `tfsdk:"name_for_phone_alerts"`
@@ -56,6 +58,7 @@ type MonitorModel struct {
 	DatabaseName                        types.String                  `tfsdk:"database_name"`
 	NetworkAddress                      types.String                  `tfsdk:"network_address"`
 	ImapSecureConnection                types.Bool                    `tfsdk:"imap_secure_connection"`
+	Pop3SecureConnection                types.Bool                    `tfsdk:"pop3_secure_connection"`
 	SftpAction                          types.String                  `tfsdk:"sftp_action"`
 	SftpActionPath                      types.String                  `tfsdk:"sftp_action_path"`
 	HttpMethod                          types.String                  `tfsdk:"http_method"`
@@ -74,6 +77,7 @@ type MonitorModel struct {
 	HttpVersion                         types.String                  `tfsdk:"http_version"`
 	UseW3CTotalTime                     types.Bool                    `tfsdk:"use_w3c_total_time"`
 	InitialMonitorGroupGuid             types.String                  `tfsdk:"initial_monitor_group_id_wo"`
+	MonitorGroupIds                     types.Set                     `tfsdk:"monitorgroup_ids"`
 }
 
 type PredefinedVariablesModel struct {
@@ -150,7 +154,8 @@ type MonitorModelForValidation struct {
 	MonitorMode                         types.String `tfsdk:"monitor_mode"`
 	Notes                               types.String `tfsdk:"notes"`
 	CustomMetrics                       types.List   `tfsdk:"custom_metrics"`
-	CustomFields                        types.List   `tfsdk:"custom_fields"`
+	CustomFields                        types.Set    `tfsdk:"custom_fields"`
+	CustomFieldsAll                     types.Set    `tfsdk:"custom_fields_all"`
 	SelectedCheckpoints                 types.Object `tfsdk:"selected_checkpoints"`
 	UsePrimaryCheckpointsOnly           types.Bool   `tfsdk:"use_primary_checkpoints_only"`
 	SelfServiceTransactionScript        types.String `tfsdk:"self_service_transaction_script"`
@@ -161,6 +166,7 @@ type MonitorModelForValidation struct {
 	RequestHeaders                      types.List   `tfsdk:"request_headers"`
 	UserAgent                           types.String `tfsdk:"user_agent"`
 	Username                            types.String `tfsdk:"username"`
+	CredentialVaultItemId               types.String `tfsdk:"credential_vault_item_id"`
 	Password                            types.String `tfsdk:"password_wo"`
 	PasswordVersion                     types.Int64  `tfsdk:"password_wo_version"`
 	NameForPhoneAlerts                  types.String `tfsdk:"name_for_phone_alerts"`
@@ -189,6 +195,7 @@ type MonitorModelForValidation struct {
 	DatabaseName                        types.String `tfsdk:"database_name"`
 	NetworkAddress                      types.String `tfsdk:"network_address"`
 	ImapSecureConnection                types.Bool   `tfsdk:"imap_secure_connection"`
+	Pop3SecureConnection                types.Bool   `tfsdk:"pop3_secure_connection"`
 	SftpAction                          types.String `tfsdk:"sftp_action"`
 	SftpActionPath                      types.String `tfsdk:"sftp_action_path"`
 	HttpMethod                          types.String `tfsdk:"http_method"`
@@ -207,6 +214,7 @@ type MonitorModelForValidation struct {
 	HttpVersion                         types.String `tfsdk:"http_version"`
 	UseW3CTotalTime                     types.Bool   `tfsdk:"use_w3c_total_time"`
 	InitialMonitorGroupGuid             types.String `tfsdk:"initial_monitor_group_id_wo"`
+	MonitorGroupIds                     types.Set    `tfsdk:"monitorgroup_ids"`
 }
 
 type MonitorModelDataSource struct {
@@ -231,6 +239,7 @@ type MonitorModelDataSource struct {
 	RequestHeaders                      *[]RequestHeaderModel         `tfsdk:"request_headers"`
 	UserAgent                           types.String                  `tfsdk:"user_agent"`
 	Username                            types.String                  `tfsdk:"username"`
+	CredentialVaultItemId               types.String                  `tfsdk:"credential_vault_item_id"`
 	NameForPhoneAlerts                  types.String                  `tfsdk:"name_for_phone_alerts"`
 	AuthenticationType                  types.String                  `tfsdk:"authentication_type"`
 	ThrottlingOptions                   *ThrottlingOptionsModel       `tfsdk:"throttling_options"`
@@ -257,6 +266,7 @@ type MonitorModelDataSource struct {
 	DatabaseName                        types.String                  `tfsdk:"database_name"`
 	NetworkAddress                      types.String                  `tfsdk:"network_address"`
 	ImapSecureConnection                types.Bool                    `tfsdk:"imap_secure_connection"`
+	Pop3SecureConnection                types.Bool                    `tfsdk:"pop3_secure_connection"`
 	SftpAction                          types.String                  `tfsdk:"sftp_action"`
 	SftpActionPath                      types.String                  `tfsdk:"sftp_action_path"`
 	HttpMethod                          types.String                  `tfsdk:"http_method"`
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	interfaces "github.com/itrs-group/terraform-provider-itrs-uptrends/client/interfaces"
+	models "github.com/itrs-group/terraform-provider-itrs-uptrends/client/models"
+)
+
+var (
+	_ datasource.DataSource                   = &monitorChecksDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &monitorChecksDataSource{}
+)
+
+// NewMonitorChecksDataSource constructs the monitor check results data source.
+func NewMonitorChecksDataSource(client interfaces.IMonitorCheck) datasource.DataSource {
+	return &monitorChecksDataSource{client: client}
+}
+
+type monitorChecksDataSource struct {
+	client interfaces.IMonitorCheck
+}
+
+type monitorCheckModel struct {
+	ID               types.Int64  `tfsdk:"id"`
+	Timestamp        types.String `tfsdk:"timestamp"`
+	ErrorCode        types.Int64  `tfsdk:"error_code"`
+	ErrorLevel       types.String `tfsdk:"error_level"`
+	HttpStatusCode   types.Int64  `tfsdk:"http_status_code"`
+	TotalTime        types.Int64  `tfsdk:"total_time"`
+	ResolveTime      types.Int64  `tfsdk:"resolve_time"`
+	ConnectionTime   types.Int64  `tfsdk:"connection_time"`
+	DownloadTime     types.Int64  `tfsdk:"download_time"`
+	CheckpointID     types.Int64  `tfsdk:"checkpoint_id"`
+	ServerID         types.Int64  `tfsdk:"server_id"`
+	ErrorDescription types.String `tfsdk:"error_description"`
+	ErrorMessage     types.String `tfsdk:"error_message"`
+}
+
+type monitorChecksDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	MonitorID     types.String `tfsdk:"monitor_id"`
+	PresetPeriod  types.String `tfsdk:"preset_period"`
+	Start         types.String `tfsdk:"start"`
+	End           types.String `tfsdk:"end"`
+	ErrorsOnly    types.Bool   `tfsdk:"errors_only"`
+	CheckpointIDs types.Set    `tfsdk:"checkpoint_ids"`
+	Checks        types.List   `tfsdk:"checks"`
+}
+
+func (d *monitorChecksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_checks"
+}
+
+func (d *monitorChecksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Internal identifier for this data source instance.",
+		},
+		"monitor_id": schema.StringAttribute{
+			Required:    true,
+			Description: "GUID of the monitor to read the checks of.",
+		},
+		"errors_only": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Only return checks that found an error.",
+		},
+		"checkpoint_ids": schema.SetAttribute{
+			ElementType: types.Int64Type,
+			Optional:    true,
+			Description: "Only return checks done from these checkpoints.",
+		},
+		"checks": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "Checks of the monitor in the period, oldest first.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Identifier of the check.",
+					},
+					"timestamp": schema.StringAttribute{
+						Computed:    true,
+						Description: "When the check was done.",
+					},
+					"error_code": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Result code of the check. 0 means the check passed.",
+					},
+					"error_level": schema.StringAttribute{
+						Computed:    true,
+						Description: "Error level of the check, e.g. NoError.",
+					},
+					"http_status_code": schema.Int64Attribute{
+						Computed:    true,
+						Description: "HTTP status code of the response, for HTTP based monitor types.",
+					},
+					"total_time": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Total duration of the check in milliseconds.",
+					},
+					"resolve_time": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Duration of the DNS lookup in milliseconds.",
+					},
+					"connection_time": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Duration of setting up the connection in milliseconds.",
+					},
+					"download_time": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Duration of the download in milliseconds.",
+					},
+					"checkpoint_id": schema.Int64Attribute{
+						Computed:    true,
+						Description: "ID of the checkpoint that did the check.",
+					},
+					"server_id": schema.Int64Attribute{
+						Computed:    true,
+						Description: "ID of the checkpoint server that did the check.",
+					},
+					"error_description": schema.StringAttribute{
+						Computed:    true,
+						Description: "Description of the error found by the check.",
+					},
+					"error_message": schema.StringAttribute{
+						Computed:    true,
+						Description: "Detailed error message of the check.",
+					},
+				},
+			},
+		},
+	}
+	maps.Copy(attributes, timeRangeAttributes())
+
+	resp.Schema = schema.Schema{
+		Description: "Reads the check results of a monitor in a period.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *monitorChecksDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data monitorChecksDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateTimeRange(data.Start, data.End, &resp.Diagnostics)
+}
+
+func (d *monitorChecksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The monitor check client was not configured. This is an internal error in the provider.")
+		return
+	}
+
+	var data monitorChecksDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var checkpointIDs []int64
+	if !data.CheckpointIDs.IsNull() {
+		resp.Diagnostics.Append(data.CheckpointIDs.ElementsAs(ctx, &checkpointIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	timeRange := timeRangeFromConfig(data.PresetPeriod, data.Start, data.End)
+	checks, statusCode, responseBody, err := d.client.GetMonitorChecks(data.MonitorID.ValueString(), timeRange)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing monitor checks", err.Error())
+		return
+	}
+	if statusCode >= 300 {
+		resp.Diagnostics.AddError(
+			"Failed to list monitor checks",
+			fmt.Sprintf("HTTP status code: %d with response body %v", statusCode, responseBody),
+		)
+		return
+	}
+
+	// The MonitorCheck endpoints don't document filters for errors or checkpoints, so the checks are filtered here.
+	matches := make([]models.MonitorCheckData, 0, len(checks))
+	for _, check := range checks {
+		if data.ErrorsOnly.ValueBool() && !isFailedCheck(check.Attributes) {
+			continue
+		}
+		if len(checkpointIDs) > 0 && !slices.Contains(checkpointIDs, int64(check.Attributes.CheckpointId)) {
+			continue
+		}
+		matches = append(matches, check)
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		left, leftOk := parseAPITime(matches[i].Attributes.Timestamp)
+		right, rightOk := parseAPITime(matches[j].Attributes.Timestamp)
+		if leftOk && rightOk {
+			return left.Before(right)
+		}
+		return matches[i].Attributes.Timestamp < matches[j].Attributes.Timestamp
+	})
+
+	results := make([]monitorCheckModel, 0, len(matches))
+	for _, check := range matches {
+		attributes := check.Attributes
+		results = append(results, monitorCheckModel{
+			ID:               types.Int64Value(check.Id),
+			Timestamp:        types.StringValue(attributes.Timestamp),
+			ErrorCode:        types.Int64Value(int64(attributes.ErrorCode)),
+			ErrorLevel:       stringValueOrNull(attributes.ErrorLevel),
+			HttpStatusCode:   types.Int64Value(int64(attributes.HttpStatusCode)),
+			TotalTime:        types.Int64Value(attributes.TotalTime),
+			ResolveTime:      types.Int64Value(attributes.ResolveTime),
+			ConnectionTime:   types.Int64Value(attributes.ConnectionTime),
+			DownloadTime:     types.Int64Value(attributes.DownloadTime),
+			CheckpointID:     types.Int64Value(int64(attributes.CheckpointId)),
+			ServerID:         types.Int64Value(int64(attributes.ServerId)),
+			ErrorDescription: stringValueOrNull(attributes.ErrorDescription),
+			ErrorMessage:     stringValueOrNull(attributes.ErrorMessage),
+		})
+	}
+
+	checksVal, diag := types.ListValueFrom(ctx, monitorCheckModelType(), results)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(data.MonitorID.ValueString())
+	data.Checks = checksVal
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// isFailedCheck reports whether a check found an error, either by its result code or by its error level.
+func isFailedCheck(attributes models.MonitorCheckAttributes) bool {
+	return attributes.ErrorCode != 0 || (attributes.ErrorLevel != "" && attributes.ErrorLevel != "NoError")
+}
+
+func monitorCheckModelType() attr.Type {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":                types.Int64Type,
+			"timestamp":         types.StringType,
+			"error_code":        types.Int64Type,
+			"error_level":       types.StringType,
+			"http_status_code":  types.Int64Type,
+			"total_time":        types.Int64Type,
+			"resolve_time":      types.Int64Type,
+			"connection_time":   types.Int64Type,
+			"download_time":     types.Int64Type,
+			"checkpoint_id":     types.Int64Type,
+			"server_id":         types.Int64Type,
+			"error_description": types.StringType,
+			"error_message":     types.StringType,
+		},
+	}
+}
@@ -180,6 +180,10 @@ func (d *monitorDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Description: "Username for authentication.",
 				Computed:    true,
 			},
+			"credential_vault_item_id": schema.StringAttribute{
+				Description: "GUID of the CredentialSet vault item used as username and password for authentication.",
+				Computed:    true,
+			},
 			"name_for_phone_alerts": schema.StringAttribute{
 				Description: "Name for phone alerts.",
 				Computed:    true,
@@ -318,6 +322,10 @@ func (d *monitorDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Description: "IMAP secure connection.",
 				Computed:    true,
 			},
+			"pop3_secure_connection": schema.BoolAttribute{
+				Description: "POP3 secure connection.",
+				Computed:    true,
+			},
 			"sftp_action": schema.StringAttribute{
 				Description: "SFTP action.",
 				Computed:    true,
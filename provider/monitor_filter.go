@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	interfaces "github.com/itrs-group/terraform-provider-itrs-uptrends/client/interfaces"
+	models "github.com/itrs-group/terraform-provider-itrs-uptrends/client/models"
+	"github.com/samber/lo"
+)
+
+// monitorFilterModel holds the monitor filters shared by the itrs-uptrends_monitors data source
+// and the itrs-uptrends_monitor list resource. Null filters match every monitor.
+type monitorFilterModel struct {
+	MonitorType      types.String `tfsdk:"monitor_type"`
+	NameRegex        types.String `tfsdk:"name_regex"`
+	MonitorMode      types.String `tfsdk:"monitor_mode"`
+	IsActive         types.Bool   `tfsdk:"is_active"`
+	CustomFieldName  types.String `tfsdk:"custom_field_name"`
+	CustomFieldValue types.String `tfsdk:"custom_field_value"`
+	MonitorGroupID   types.String `tfsdk:"monitorgroup_id"`
+}
+
+// filterMonitors returns the monitors that match filter, sorted by name, then GUID, for a deterministic result.
+func filterMonitors(monitors []models.MonitorResponse, filter monitorFilterModel, membershipClient interfaces.IMonitorGroupMember) ([]models.MonitorResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	nameRegex, regexDiags := compileNameRegex(filter.NameRegex)
+	diags.Append(regexDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var groupMembers map[string]bool
+	if !filter.MonitorGroupID.IsNull() {
+		memberships, err := membershipClient.GetGroupMemberships(filter.MonitorGroupID.ValueString())
+		if err != nil {
+			diags.AddError("Error reading monitor group memberships", err.Error())
+			return nil, diags
+		}
+		groupMembers = make(map[string]bool, len(memberships))
+		for _, m := range memberships {
+			groupMembers[m.MonitorGuid] = true
+		}
+	}
+
+	matches := lo.Filter(monitors, func(m models.MonitorResponse, _ int) bool {
+		if !filter.MonitorType.IsNull() && !strings.EqualFold(m.MonitorType, filter.MonitorType.ValueString()) {
+			return false
+		}
+		if nameRegex != nil && !nameRegex.MatchString(m.Name) {
+			return false
+		}
+		if !filter.MonitorMode.IsNull() && m.MonitorMode != filter.MonitorMode.ValueString() {
+			return false
+		}
+		if !filter.IsActive.IsNull() && m.IsActive != filter.IsActive.ValueBool() {
+			return false
+		}
+		if !filter.CustomFieldName.IsNull() {
+			field, found := lo.Find(m.CustomFields, func(cf models.CustomField) bool {
+				return cf.Name == filter.CustomFieldName.ValueString()
+			})
+			if !found {
+				return false
+			}
+			if !filter.CustomFieldValue.IsNull() && field.Value != filter.CustomFieldValue.ValueString() {
+				return false
+			}
+		}
+		if groupMembers != nil && !groupMembers[m.MonitorGuid] {
+			return false
+		}
+		return true
+	})
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Name != matches[j].Name {
+			return matches[i].Name < matches[j].Name
+		}
+		return matches[i].MonitorGuid < matches[j].MonitorGuid
+	})
+	return matches, diags
+}
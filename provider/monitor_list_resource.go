@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	interfaces "github.com/itrs-group/terraform-provider-itrs-uptrends/client/interfaces"
+	converters "github.com/itrs-group/terraform-provider-itrs-uptrends/converters/monitor"
+)
+
+var _ list.ListResource = &monitorListResource{}
+
+// NewMonitorListResource constructs the list resource for monitors.
+func NewMonitorListResource(client interfaces.IMonitor, monitorGroupClient interfaces.IMonitorGroupClient, membershipClient interfaces.IMonitorGroupMember, defaults converters.MonitorDefaults) list.ListResource {
+	return &monitorListResource{
+		client:             client,
+		monitorGroupClient: monitorGroupClient,
+		membershipClient:   membershipClient,
+		defaults:           defaults,
+	}
+}
+
+type monitorListResource struct {
+	client             interfaces.IMonitor
+	monitorGroupClient interfaces.IMonitorGroupClient
+	membershipClient   interfaces.IMonitorGroupMember
+	defaults           converters.MonitorDefaults
+}
+
+func (r *monitorListResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "itrs-uptrends_monitor"
+}
+
+func (r *monitorListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists the monitors in the account, optionally filtered.",
+		Attributes: map[string]listschema.Attribute{
+			"monitor_type": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list monitors of this monitor type, e.g. Https.",
+			},
+			"name_regex": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list monitors whose name matches this regular expression.",
+			},
+			"monitor_mode": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list monitors in this monitor mode.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"Development",
+						"Staging",
+						"Production",
+					),
+				},
+			},
+			"is_active": listschema.BoolAttribute{
+				Optional:    true,
+				Description: "Only list active (true) or inactive (false) monitors.",
+			},
+			"custom_field_name": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list monitors that have a custom field with this name.",
+			},
+			"custom_field_value": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list monitors whose custom field named custom_field_name has this value.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("custom_field_name")),
+				},
+			},
+			"monitorgroup_id": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list monitors that are a member of this monitor group.",
+			},
+		},
+	}
+}
+
+func (r *monitorListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var filter monitorFilterModel
+	diags := req.Config.Get(ctx, &filter)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	monitors, statusCode, responseBody, err := r.client.GetMonitors()
+	if err != nil {
+		diags.AddError("Error listing monitors", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	if statusCode >= 300 {
+		diags.AddError(
+			"Failed to list monitors",
+			fmt.Sprintf("HTTP status code: %d with response body %v", statusCode, responseBody),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	matches, filterDiags := filterMonitors(monitors, filter, r.membershipClient)
+	diags.Append(filterDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var monitorGroupIds map[string][]string
+	if req.IncludeResource {
+		monitorGroupIds, err = r.monitorGroupIdsByMonitor()
+		if err != nil {
+			diags.AddError("Error reading monitor group memberships", err.Error())
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	results := make([]list.ListResult, 0, len(matches))
+	for i := range matches {
+		monitor := &matches[i]
+		result := newGuidListResult(ctx, req, monitor.MonitorGuid, monitor.Name)
+		if req.IncludeResource {
+			state := converters.UpdateStateConversion(monitor)
+			state.CustomFields = converters.StripDefaultCustomFields(state.CustomFields, r.defaults, nil)
+			groupIds, setDiags := types.SetValueFrom(ctx, types.StringType, append([]string{}, monitorGroupIds[monitor.MonitorGuid]...))
+			result.Diagnostics.Append(setDiags...)
+			state.MonitorGroupIds = groupIds
+			result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+		}
+		results = append(results, result)
+	}
+	streamListResults(req, results, stream)
+}
+
+// monitorGroupIdsByMonitor returns the monitor groups of every monitor, excluding the "All monitors" group.
+func (r *monitorListResource) monitorGroupIdsByMonitor() (map[string][]string, error) {
+	monitorGroups, _, _, err := r.monitorGroupClient.GetMonitorGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	monitorGroupIds := map[string][]string{}
+	for _, monitorGroup := range monitorGroups {
+		if monitorGroup.IsAll {
+			continue
+		}
+		memberships, err := r.membershipClient.GetGroupMemberships(monitorGroup.MonitorGroupGuid)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range memberships {
+			monitorGroupIds[m.MonitorGuid] = append(monitorGroupIds[m.MonitorGuid], monitorGroup.MonitorGroupGuid)
+		}
+	}
+	return monitorGroupIds, nil
+}
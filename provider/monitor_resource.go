@@ -3,9 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -14,30 +18,52 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	interfaces "github.com/itrs-group/terraform-provider-itrs-uptrends/client/interfaces"
+	models "github.com/itrs-group/terraform-provider-itrs-uptrends/client/models"
 	"github.com/itrs-group/terraform-provider-itrs-uptrends/constants"
 	converters "github.com/itrs-group/terraform-provider-itrs-uptrends/converters/monitor"
 	"github.com/itrs-group/terraform-provider-itrs-uptrends/helpers"
 	tfsdkmodels "github.com/itrs-group/terraform-provider-itrs-uptrends/provider/models"
+	"github.com/samber/lo"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var _ resource.Resource = &monitorResource{}
 var _ resource.ResourceWithConfigure = &monitorResource{}
 var _ resource.ResourceWithValidateConfig = &monitorResource{}
+var _ resource.ResourceWithUpgradeState = &monitorResource{}
+var _ resource.ResourceWithModifyPlan = &monitorResource{}
+var _ resource.ResourceWithIdentity = &monitorResource{}
 
 // monitorResource implements the Terraform resource.
 type monitorResource struct {
-	client interfaces.IMonitor
+	client             interfaces.IMonitor
+	monitorGroupClient interfaces.IMonitorGroupClient
+	membershipClient   interfaces.IMonitorGroupMember
+	checkpointClient   interfaces.ICheckpoint
+	vaultItemClient    interfaces.IVaultItem
+	defaults           converters.MonitorDefaults
 }
 
-// NewMonitorResource creates a new instance of monitorResource using the provided client.
-func NewMonitorResource(client interfaces.IMonitor) resource.Resource {
+// NewMonitorResource creates a new instance of monitorResource using the provided clients.
+// The monitor group clients are used to keep the monitorgroup_ids attribute in sync,
+// the checkpoint client resolves selected checkpoints when validating concurrent monitoring,
+// the vault item client resolves credential_vault_item_id,
+// and the defaults are the provider-level values applied to every monitor.
+func NewMonitorResource(client interfaces.IMonitor, monitorGroupClient interfaces.IMonitorGroupClient, membershipClient interfaces.IMonitorGroupMember, checkpointClient interfaces.ICheckpoint, vaultItemClient interfaces.IVaultItem, defaults converters.MonitorDefaults) resource.Resource {
 	return &monitorResource{
-		client: client,
+		client:             client,
+		monitorGroupClient: monitorGroupClient,
+		membershipClient:   membershipClient,
+		checkpointClient:   checkpointClient,
+		vaultItemClient:    vaultItemClient,
+		defaults:           defaults,
 	}
 }
 
@@ -48,6 +74,8 @@ func (r *monitorResource) Metadata(ctx context.Context, req resource.MetadataReq
 
 func (r *monitorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		// Version 1 changed custom_fields from a list to a set.
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 
 			"id": schema.StringAttribute{
@@ -151,12 +179,12 @@ func (r *monitorResource) Schema(ctx context.Context, req resource.SchemaRequest
 					},
 				},
 			},
-			"custom_fields": schema.ListNestedAttribute{
-				Description: "List of custom fields",
+			"custom_fields": schema.SetNestedAttribute{
+				Description: "Set of custom fields. Field names must be unique",
 				Optional:    true,
 				Computed:    true,
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.UseStateForUnknown(),
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
 				},
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -171,6 +199,22 @@ func (r *monitorResource) Schema(ctx context.Context, req resource.SchemaRequest
 					},
 				},
 			},
+			"custom_fields_all": schema.SetNestedAttribute{
+				Description: "Set of all custom fields of the monitor, including the default custom fields of the provider",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Field name",
+							Computed:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "Field value",
+							Computed:    true,
+						},
+					},
+				},
+			},
 			"selected_checkpoints": schema.SingleNestedAttribute{
 				Description: "Selected checkpoints configuration",
 				Optional:    true,
@@ -314,6 +358,21 @@ func (r *monitorResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Description: "Version of the password",
 				Optional:    true,
 			},
+			"credential_vault_item_id": schema.StringAttribute{
+				Description: "GUID of a CredentialSet vault item to use as username and password for authentication",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("username"),
+						path.MatchRoot("password_wo"),
+						path.MatchRoot("password_wo_version"),
+					),
+				},
+			},
 			"name_for_phone_alerts": schema.StringAttribute{
 				Description: "Name for phone alerts",
 				Optional:    true,
@@ -620,6 +679,14 @@ func (r *monitorResource) Schema(ctx context.Context, req resource.SchemaRequest
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"pop3_secure_connection": schema.BoolAttribute{
+				Description: "POP3 secure connection",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"sftp_action": schema.StringAttribute{
 				Description: "SFTP action",
 				Optional:    true,
@@ -773,7 +840,7 @@ func (r *monitorResource) Schema(ctx context.Context, req resource.SchemaRequest
 						},
 					},
 					"mobile_device": schema.StringAttribute{
-						Description: "Mobile device name",
+						Description: "Mobile device name. Must be one of the devices listed by the itrs-uptrends_mobile_devices data source. Width, height and pixel ratio default to the native dimensions of the device",
 						Optional:    true,
 						Computed:    true,
 						PlanModifiers: []planmodifier.String{
@@ -952,6 +1019,11 @@ func (r *monitorResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"monitorgroup_ids": schema.SetAttribute{
+				Description: "Monitor group GUIDs the monitor is a member of, excluding the All monitors group",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -983,11 +1055,37 @@ func (r *monitorResource) ValidateConfig(ctx context.Context, req resource.Valid
 		}
 	}
 
-	if monitorType == "Http" || monitorType == "WebserviceHttp" || monitorType == "WebserviceHttps" {
-		// Skip required/allowed attribute validation for these monitor types
-		return
+	r.validateCheckInterval(config, resp)
+	r.validateConcurrentMonitoring(config, resp)
+	r.validateBrowserWindowDimensions(ctx, config, resp)
+
+	if !config.CustomFields.IsNull() && !config.CustomFields.IsUnknown() {
+		var customFields []tfsdkmodels.CustomFieldModel
+		diags = config.CustomFields.ElementsAs(ctx, &customFields, false)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		// custom_fields is a set, so Terraform has already merged entries with the same name and value.
+		// Only names used with different values are left to report.
+		seen := make(map[string]bool, len(customFields))
+		for _, cf := range customFields {
+			if cf.Name.IsUnknown() {
+				continue
+			}
+			if seen[cf.Name.ValueString()] {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("custom_fields"),
+					"Invalid configuration",
+					fmt.Sprintf("Custom field %q is defined more than once. Each custom field name can only be used once per monitor.", cf.Name.ValueString()),
+				)
+			}
+			seen[cf.Name.ValueString()] = true
+		}
 	}
 
+	r.validateErrorConditions(ctx, config, resp)
+
 	// Check if all required attributes are provided
 	required := helpers.GetRequiredAttributes(monitorType, constants.MonitorResourceAttributes)
 	err = helpers.ValidateRequiredAttributes("itrs-uptrends_monitor", monitorType, config, required)
@@ -1003,6 +1101,242 @@ func (r *monitorResource) ValidateConfig(ctx context.Context, req resource.Valid
 	}
 }
 
+// validateCheckInterval checks the configured check interval against the rules in constants.CheckIntervalRules.
+func (r *monitorResource) validateCheckInterval(config tfsdkmodels.MonitorModelForValidation, resp *resource.ValidateConfigResponse) {
+	if config.MonitorType.IsNull() || config.MonitorType.IsUnknown() {
+		return
+	}
+	rule, ok := constants.CheckIntervalRules[config.MonitorType.ValueString()]
+	if !ok {
+		return
+	}
+
+	var intervalPath path.Path
+	var seconds int64
+	switch {
+	case !config.CheckIntervalSeconds.IsNull():
+		if config.CheckIntervalSeconds.IsUnknown() {
+			return
+		}
+		intervalPath = path.Root("check_interval_seconds")
+		seconds = config.CheckIntervalSeconds.ValueInt64()
+	case !config.CheckInterval.IsNull():
+		if config.CheckInterval.IsUnknown() {
+			return
+		}
+		intervalPath = path.Root("check_interval")
+		seconds = config.CheckInterval.ValueInt64() * 60
+	default:
+		return
+	}
+
+	if err := rule.ValidateCheckInterval(config.MonitorType.ValueString(), seconds); err != nil {
+		resp.Diagnostics.AddAttributeError(intervalPath, "Invalid check interval", err.Error()+".")
+	}
+}
+
+// validateConcurrentMonitoring checks that both error thresholds are set when concurrent monitoring is enabled,
+// and that the confirmed threshold doesn't exceed the unconfirmed threshold.
+func (r *monitorResource) validateConcurrentMonitoring(config tfsdkmodels.MonitorModelForValidation, resp *resource.ValidateConfigResponse) {
+	if config.UseConcurrentMonitoring.IsUnknown() || !config.UseConcurrentMonitoring.ValueBool() {
+		return
+	}
+
+	for _, threshold := range concurrentThresholds(config) {
+		if threshold.value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(threshold.name),
+				"Invalid concurrent monitoring configuration",
+				fmt.Sprintf("Attribute %q is required when use_concurrent_monitoring is true.", threshold.name),
+			)
+		}
+	}
+
+	unconfirmed := config.ConcurrentUnconfirmedErrorThreshold
+	confirmed := config.ConcurrentConfirmedErrorThreshold
+	if unconfirmed.IsNull() || unconfirmed.IsUnknown() || confirmed.IsNull() || confirmed.IsUnknown() {
+		return
+	}
+	if confirmed.ValueInt64() > unconfirmed.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("concurrent_confirmed_error_threshold"),
+			"Invalid concurrent monitoring configuration",
+			fmt.Sprintf("concurrent_confirmed_error_threshold (%d) can't be greater than concurrent_unconfirmed_error_threshold (%d).",
+				confirmed.ValueInt64(), unconfirmed.ValueInt64()),
+		)
+	}
+}
+
+// validateBrowserWindowDimensions checks mobile_device against constants.MobileDevices,
+// and warns about dimensions that don't match the selected device or a desktop browser.
+// Uptrends doesn't publish its device list, so these are warnings rather than errors.
+func (r *monitorResource) validateBrowserWindowDimensions(ctx context.Context, config tfsdkmodels.MonitorModelForValidation, resp *resource.ValidateConfigResponse) {
+	if config.BrowserWindowDimensions.IsNull() || config.BrowserWindowDimensions.IsUnknown() {
+		return
+	}
+	var dimensions tfsdkmodels.BrowserWindowDimensionsModel
+	diags := config.BrowserWindowDimensions.As(ctx, &dimensions, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	dimensionsPath := path.Root("browser_window_dimensions")
+	if dimensions.MobileDevice.IsUnknown() {
+		return
+	}
+	if dimensions.MobileDevice.ValueString() == "" {
+		isDesktop := !dimensions.IsMobile.IsNull() && !dimensions.IsMobile.IsUnknown() && !dimensions.IsMobile.ValueBool()
+		if isDesktop && !dimensions.PixelRatio.IsNull() && !dimensions.PixelRatio.IsUnknown() && dimensions.PixelRatio.ValueInt64() != 1 {
+			resp.Diagnostics.AddAttributeWarning(
+				dimensionsPath.AtName("pixel_ratio"),
+				"Unexpected browser window dimensions",
+				"A pixel_ratio other than 1 is only supported for mobile devices. Set mobile_device to emulate a mobile device.",
+			)
+		}
+		return
+	}
+
+	device, ok := helpers.FindMobileDevice(constants.MobileDevices, dimensions.MobileDevice.ValueString())
+	if !ok {
+		resp.Diagnostics.AddAttributeWarning(
+			dimensionsPath.AtName("mobile_device"),
+			"Unexpected browser window dimensions",
+			fmt.Sprintf("Mobile device %q is not in the built-in device catalog, so its dimensions can't be checked. Known devices are: %s.",
+				dimensions.MobileDevice.ValueString(), strings.Join(helpers.MobileDeviceNames(constants.MobileDevices), ", ")),
+		)
+		return
+	}
+
+	if !dimensions.IsMobile.IsNull() && !dimensions.IsMobile.IsUnknown() && !dimensions.IsMobile.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(
+			dimensionsPath.AtName("is_mobile"),
+			"Unexpected browser window dimensions",
+			fmt.Sprintf("is_mobile is false while mobile_device is set to %q. Remove is_mobile or set it to true to emulate the device.", device.Name),
+		)
+	}
+	for _, dimension := range []struct {
+		name   string
+		value  types.Int64
+		native int64
+	}{
+		{"width", dimensions.Width, device.Width},
+		{"height", dimensions.Height, device.Height},
+		{"pixel_ratio", dimensions.PixelRatio, device.PixelRatio},
+	} {
+		if dimension.value.IsNull() || dimension.value.IsUnknown() || dimension.value.ValueInt64() == dimension.native {
+			continue
+		}
+		resp.Diagnostics.AddAttributeWarning(
+			dimensionsPath.AtName(dimension.name),
+			"Unexpected browser window dimensions",
+			fmt.Sprintf("The native %s of mobile device %q is %d, got %d. Remove %s to use the native value of the device.",
+				dimension.name, device.Name, dimension.native, dimension.value.ValueInt64(), dimension.name),
+		)
+	}
+}
+
+type concurrentThreshold struct {
+	name  string
+	value types.Int64
+}
+
+func concurrentThresholds(config tfsdkmodels.MonitorModelForValidation) []concurrentThreshold {
+	return []concurrentThreshold{
+		{"concurrent_unconfirmed_error_threshold", config.ConcurrentUnconfirmedErrorThreshold},
+		{"concurrent_confirmed_error_threshold", config.ConcurrentConfirmedErrorThreshold},
+	}
+}
+
+// validateErrorConditions checks each error condition against the rules in constants.ErrorConditionAttributes.
+func (r *monitorResource) validateErrorConditions(ctx context.Context, config tfsdkmodels.MonitorModelForValidation, resp *resource.ValidateConfigResponse) {
+	if config.ErrorConditions.IsNull() || config.ErrorConditions.IsUnknown() {
+		return
+	}
+
+	var errorConditions []tfsdkmodels.ErrorConditionModel
+	diags := config.ErrorConditions.ElementsAs(ctx, &errorConditions, false)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	monitorType := config.MonitorType
+	for i, ec := range errorConditions {
+		conditionPath := path.Root("error_conditions").AtListIndex(i)
+		if ec.ErrorConditionType.IsNull() || ec.ErrorConditionType.IsUnknown() {
+			continue
+		}
+		conditionType := ec.ErrorConditionType.ValueString()
+		rule, ok := constants.ErrorConditionAttributes[conditionType]
+		if !ok {
+			// Unknown types are reported by the schema validator.
+			continue
+		}
+
+		// The monitor types and optional attributes of the table are not documented exhaustively by Uptrends,
+		// so combinations that aren't in it are warned about and left for the API to accept or reject.
+		if !monitorType.IsNull() && !monitorType.IsUnknown() && !rule.SupportsMonitorType(monitorType.ValueString()) {
+			resp.Diagnostics.AddAttributeWarning(
+				conditionPath.AtName("error_condition_type"),
+				"Unexpected error condition",
+				fmt.Sprintf("Error condition type %q is not known to be supported for monitor type %q. Known monitor types are: %s. The API may reject it.",
+					conditionType, monitorType.ValueString(), strings.Join(rule.MonitorTypes, ", ")),
+			)
+			continue
+		}
+
+		fields := map[string]types.String{
+			"value":      ec.Value,
+			"percentage": ec.Percentage,
+			"level":      ec.Level,
+			"match_type": ec.MatchType,
+			"effect":     ec.Effect,
+		}
+		allowed := append(append([]string{}, rule.RequiredAttributes...), rule.OptionalAttributes...)
+		for _, name := range []string{"value", "percentage", "level", "match_type", "effect"} {
+			if fields[name].IsNull() || lo.Contains(allowed, name) {
+				continue
+			}
+			resp.Diagnostics.AddAttributeWarning(
+				conditionPath.AtName(name),
+				"Unexpected error condition attribute",
+				fmt.Sprintf("Attribute %q is not known to be used by error condition type %q. Known attributes are: %s. The API may ignore or reject it.",
+					name, conditionType, strings.Join(allowed, ", ")),
+			)
+		}
+		for _, name := range rule.RequiredAttributes {
+			if fields[name].IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					conditionPath,
+					"Invalid error condition",
+					fmt.Sprintf("Attribute %q is required for error condition type %q.", name, conditionType),
+				)
+			}
+		}
+
+		if !ec.Value.IsNull() && !ec.Value.IsUnknown() && rule.ValueFormat != "" {
+			if err := helpers.ValidateValueFormat(rule.ValueFormat, ec.Value.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					conditionPath.AtName("value"),
+					"Invalid error condition",
+					fmt.Sprintf("Invalid value for error condition type %q: %s.", conditionType, err.Error()),
+				)
+			}
+		}
+		if !ec.Percentage.IsNull() && !ec.Percentage.IsUnknown() {
+			percentage, err := strconv.Atoi(strings.TrimSpace(ec.Percentage.ValueString()))
+			if err != nil || percentage < 0 || percentage > 100 {
+				resp.Diagnostics.AddAttributeError(
+					conditionPath.AtName("percentage"),
+					"Invalid error condition",
+					fmt.Sprintf("Percentage must be a whole number between 0 and 100, got %q.", ec.Percentage.ValueString()),
+				)
+			}
+		}
+	}
+}
+
 func (r *monitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state tfsdkmodels.MonitorModel
 	diags := req.State.Get(ctx, &state)
@@ -1010,6 +1344,12 @@ func (r *monitorResource) Read(ctx context.Context, req resource.ReadRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, state.MonitorGuid)...)
+
+	// Custom fields already in the state are managed by the resource, even when they also have a provider default.
+	managedCustomFields := state.CustomFields
+	// Only the memberships listed in monitorgroup_ids are managed, so only those are checked.
+	managedMonitorGroupIds := state.MonitorGroupIds
 
 	// Get the password version from the state.
 	passwordVersion := types.Int64Null()
@@ -1025,9 +1365,18 @@ func (r *monitorResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	state = converters.UpdateStateConversion(getMonitor)
+	state.CustomFields = converters.StripDefaultCustomFields(state.CustomFields, r.defaults, managedCustomFields)
 	// Keep the previous password version from the state when the user applies changes from UI to terraform state.
 	state.PasswordVersion = passwordVersion
 
+	if !managedMonitorGroupIds.IsNull() {
+		state.MonitorGroupIds, err = r.readManagedMonitorGroupIds(ctx, state.MonitorGuid.ValueString(), managedMonitorGroupIds)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading monitor group memberships", err.Error())
+			return
+		}
+	}
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -1040,7 +1389,7 @@ func (r *monitorResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	payload := converters.PayloadConversion(config)
+	payload := converters.PayloadConversion(config, r.defaults)
 
 	var initialMonitorGroupGuid *string
 	if !config.InitialMonitorGroupGuid.IsNull() {
@@ -1064,21 +1413,21 @@ func (r *monitorResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	var state = converters.UpdateStateConversion(result)
+	state.CustomFields = converters.StripDefaultCustomFields(state.CustomFields, r.defaults, config.CustomFields)
 	if !config.PasswordVersion.IsNull() {
 		state.PasswordVersion = types.Int64Value(config.PasswordVersion.ValueInt64())
 	}
 
+	// The monitor exists at this point, so membership errors are reported after saving the state.
+	// The state then holds the memberships that were added before the error.
+	var syncErr error
+	state.MonitorGroupIds, syncErr = r.syncMonitorGroupIds(ctx, result.MonitorGuid, types.SetNull(types.StringType), config.MonitorGroupIds)
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
-	if initialMonitorGroupGuid != nil {
-		resp.Diagnostics.AddWarning(
-			"When you create a monitor with a monitor group specified, it will automatically add it to that group. Import the following itrs-uptrends_monitorgroup_membership resource to manage this.",
-			fmt.Sprintf(`import {
-			to = itrs-uptrends_monitorgroup_membership.monitorgroup_membership_for_%s
-			id = "%s:%s"
-			provider = itrs-uptrends.uptrendsauthenticated
-			}`, result.MonitorGuid, result.MonitorGuid, *initialMonitorGroupGuid),
-		)
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, state.MonitorGuid)...)
+	if syncErr != nil {
+		resp.Diagnostics.AddError("Error updating monitor group memberships", syncErr.Error())
 	}
 }
 
@@ -1099,7 +1448,13 @@ func (r *monitorResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	monitorGuid := state.MonitorGuid.ValueString()
 
-	payload := converters.PayloadConversion(config)
+	// Without custom_fields in the configuration, the fields in the state are kept,
+	// so merging in the provider defaults doesn't drop them.
+	if config.CustomFields == nil {
+		config.CustomFields = state.CustomFields
+	}
+
+	payload := converters.PayloadConversion(config, r.defaults)
 
 	statusCode, msg, err := r.client.UpdateMonitor(monitorGuid, payload)
 	if err != nil {
@@ -1114,16 +1469,25 @@ func (r *monitorResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	monitorGroupIds, syncErr := r.syncMonitorGroupIds(ctx, monitorGuid, state.MonitorGroupIds, config.MonitorGroupIds)
+
 	// Re-read from the server to get the latest data
 	getMonitor, _ := r.client.GetMonitor(monitorGuid)
 
 	state = converters.UpdateStateConversion(getMonitor)
+	state.CustomFields = converters.StripDefaultCustomFields(state.CustomFields, r.defaults, config.CustomFields)
 	if !config.PasswordVersion.IsNull() {
 		state.PasswordVersion = types.Int64Value(config.PasswordVersion.ValueInt64())
 	}
+
+	state.MonitorGroupIds = monitorGroupIds
 	// Update state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, state.MonitorGuid)...)
+	if syncErr != nil {
+		resp.Diagnostics.AddError("Error updating monitor group memberships", syncErr.Error())
+	}
 }
 
 func (r *monitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -1167,7 +1531,795 @@ func (r *monitorResource) Configure(ctx context.Context, req resource.ConfigureR
 	r.client = clientReturned
 }
 
+// ModifyPlan applies the provider defaults to the plan, so a change of the defaults shows up as a diff on
+// custom_fields_all and notes, while the default custom fields stay out of custom_fields.
+func (r *monitorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// The resource is being destroyed.
+		return
+	}
+
+	r.validateConcurrentCheckpoints(ctx, req, resp)
+	r.validateCredentialVaultItem(ctx, req, resp)
+	r.validatePlaceholders(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var configNotes types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("notes"), &configNotes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if configNotes.IsNull() && r.defaults.Notes != nil {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("notes"), types.StringValue(*r.defaults.Notes))...)
+	}
+
+	r.planMobileDeviceDimensions(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// With a vault item, the username is read back as credential_vault_item_id, so it must not keep a value from the state.
+	var configVaultItemId types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("credential_vault_item_id"), &configVaultItemId)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !configVaultItemId.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("username"), types.StringNull())...)
+	}
+
+	// Switching to inline credentials drops the vault reference, so the vault item from the state must not be kept.
+	var configUsername types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("username"), &configUsername)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if configVaultItemId.IsNull() && !configUsername.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("credential_vault_item_id"), types.StringNull())...)
+	}
+
+	var configCustomFields types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("custom_fields"), &configCustomFields)...)
+	var planCustomFields types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("custom_fields"), &planCustomFields)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without custom_fields in the configuration, the plan holds the fields from the state, if any.
+	userCustomFields := configCustomFields
+	if configCustomFields.IsNull() {
+		userCustomFields = planCustomFields
+	}
+
+	if userCustomFields.IsUnknown() {
+		if configCustomFields.IsNull() && req.State.Raw.IsNull() {
+			// Nothing is set on a new monitor, so only the defaults are going to be applied.
+			userCustomFields = types.SetValueMust(customFieldObjectType(), nil)
+		} else {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("custom_fields_all"), types.SetUnknown(customFieldObjectType()))...)
+			return
+		}
+	}
+
+	var userFields []tfsdkmodels.CustomFieldModel
+	if !userCustomFields.IsNull() {
+		resp.Diagnostics.Append(userCustomFields.ElementsAs(ctx, &userFields, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	for _, cf := range userFields {
+		if cf.Name.IsUnknown() || cf.Value.IsUnknown() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("custom_fields_all"), types.SetUnknown(customFieldObjectType()))...)
+			return
+		}
+	}
+
+	merged := converters.MergeCustomFields(&userFields, r.defaults)
+	customFieldsAll, diags := types.SetValueFrom(ctx, customFieldObjectType(), merged)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		stateCustomFieldsAll := types.SetNull(customFieldObjectType())
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("custom_fields_all"), &stateCustomFieldsAll)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// Keep the state value when nothing changed, so unchanged defaults don't show up in the diff.
+		if stateCustomFieldsAll.Equal(customFieldsAll) {
+			customFieldsAll = stateCustomFieldsAll
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("custom_fields_all"), customFieldsAll)...)
+}
+
+// planMobileDeviceDimensions fills in is_mobile, width, height and pixel_ratio from the catalog
+// when browser_window_dimensions selects a mobile device. Values set in the configuration are kept.
+func (r *monitorResource) planMobileDeviceDimensions(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	dimensionsPath := path.Root("browser_window_dimensions")
+
+	var dimensionsObject types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, dimensionsPath, &dimensionsObject)...)
+	if resp.Diagnostics.HasError() || dimensionsObject.IsNull() || dimensionsObject.IsUnknown() {
+		return
+	}
+	var dimensions tfsdkmodels.BrowserWindowDimensionsModel
+	resp.Diagnostics.Append(dimensionsObject.As(ctx, &dimensions, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() || dimensions.MobileDevice.IsNull() || dimensions.MobileDevice.IsUnknown() {
+		return
+	}
+	device, ok := helpers.FindMobileDevice(constants.MobileDevices, dimensions.MobileDevice.ValueString())
+	if !ok {
+		return
+	}
+
+	if dimensions.IsMobile.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, dimensionsPath.AtName("is_mobile"), types.BoolValue(true))...)
+	}
+	for _, dimension := range []struct {
+		name   string
+		value  types.Int64
+		native int64
+	}{
+		{"width", dimensions.Width, device.Width},
+		{"height", dimensions.Height, device.Height},
+		{"pixel_ratio", dimensions.PixelRatio, device.PixelRatio},
+	} {
+		if dimension.value.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, dimensionsPath.AtName(dimension.name), types.Int64Value(dimension.native))...)
+		}
+	}
+}
+
+// minimumConcurrentRegionCheckpoints is the number of checkpoints below which a region is considered too small
+// for concurrent monitoring without use_primary_checkpoints_only.
+const minimumConcurrentRegionCheckpoints = 3
+
+// validateConcurrentCheckpoints checks the concurrent monitoring thresholds against the selected checkpoints.
+// Checkpoints are resolved through the API, which is why this runs at plan time instead of in ValidateConfig.
+func (r *monitorResource) validateConcurrentCheckpoints(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.checkpointClient == nil {
+		return
+	}
+
+	var config tfsdkmodels.MonitorModelForValidation
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.UseConcurrentMonitoring.IsUnknown() || !config.UseConcurrentMonitoring.ValueBool() {
+		return
+	}
+	if config.SelectedCheckpoints.IsNull() || config.SelectedCheckpoints.IsUnknown() {
+		return
+	}
+
+	var selected tfsdkmodels.SelectedCheckpointsModel
+	resp.Diagnostics.Append(config.SelectedCheckpoints.As(ctx, &selected, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if selected.Checkpoints.IsUnknown() || selected.Regions.IsUnknown() || selected.ExcludeLocations.IsUnknown() {
+		return
+	}
+
+	var checkpointIds, regionIds, excludedIds []int64
+	for _, list := range []struct {
+		value  types.List
+		target *[]int64
+	}{
+		{selected.Checkpoints, &checkpointIds},
+		{selected.Regions, &regionIds},
+		{selected.ExcludeLocations, &excludedIds},
+	} {
+		if list.value.IsNull() {
+			continue
+		}
+		resp.Diagnostics.Append(list.value.ElementsAs(ctx, list.target, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(checkpointIds) > 0 && len(regionIds) == 0 {
+		r.validateConcurrentThresholdsAgainstCheckpoints(config, checkpointIds, excludedIds, resp)
+	}
+	if len(regionIds) > 0 && !config.UsePrimaryCheckpointsOnly.IsNull() && !config.UsePrimaryCheckpointsOnly.IsUnknown() && !config.UsePrimaryCheckpointsOnly.ValueBool() {
+		r.warnForTinyRegions(regionIds, excludedIds, resp)
+	}
+}
+
+// validateConcurrentThresholdsAgainstCheckpoints checks that neither threshold exceeds the number of checkpoints
+// in an explicit checkpoint list. With use_primary_checkpoints_only, only primary checkpoints are counted.
+func (r *monitorResource) validateConcurrentThresholdsAgainstCheckpoints(config tfsdkmodels.MonitorModelForValidation, checkpointIds, excludedIds []int64, resp *resource.ModifyPlanResponse) {
+	checkpoints, statusCode, responseBody, err := r.checkpointClient.GetCheckpoints()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading checkpoints", err.Error())
+		return
+	}
+	if statusCode >= 300 {
+		resp.Diagnostics.AddError(
+			"Failed to read checkpoints",
+			fmt.Sprintf("HTTP status code: %d with response body %v", statusCode, responseBody),
+		)
+		return
+	}
+
+	checkpointsById := lo.KeyBy(checkpoints.Data, func(c models.Checkpoint) int64 {
+		return int64(c.Id)
+	})
+	primaryOnly := !config.UsePrimaryCheckpointsOnly.IsNull() && !config.UsePrimaryCheckpointsOnly.IsUnknown() && config.UsePrimaryCheckpointsOnly.ValueBool()
+
+	count := 0
+	for _, id := range lo.Uniq(lo.Without(checkpointIds, excludedIds...)) {
+		checkpoint, ok := checkpointsById[id]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("selected_checkpoints").AtName("checkpoints"),
+				"Invalid selected checkpoints",
+				fmt.Sprintf("Checkpoint %d doesn't exist. Use the itrs-uptrends_checkpoint data source to look up checkpoint IDs.", id),
+			)
+			continue
+		}
+		if primaryOnly && !checkpoint.Attributes.IsPrimaryCheckpoint {
+			continue
+		}
+		count++
+	}
+
+	description := "selected checkpoints"
+	if primaryOnly {
+		description = "selected primary checkpoints"
+	}
+	for _, threshold := range concurrentThresholds(config) {
+		if threshold.value.IsNull() || threshold.value.IsUnknown() {
+			continue
+		}
+		if threshold.value.ValueInt64() > int64(count) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(threshold.name),
+				"Invalid concurrent monitoring configuration",
+				fmt.Sprintf("%s (%d) can't be greater than the number of %s (%d).", threshold.name, threshold.value.ValueInt64(), description, count),
+			)
+		}
+	}
+}
+
+// warnForTinyRegions warns about selected regions with fewer than minimumConcurrentRegionCheckpoints checkpoints.
+// Without use_primary_checkpoints_only, such a region can't spread concurrent checks over enough reliable checkpoints.
+func (r *monitorResource) warnForTinyRegions(regionIds, excludedIds []int64, resp *resource.ModifyPlanResponse) {
+	for _, regionId := range lo.Uniq(regionIds) {
+		checkpoints, statusCode, responseBody, err := r.checkpointClient.GetCheckpointRegionCheckpoints(int(regionId))
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading checkpoint region", err.Error())
+			return
+		}
+		if statusCode >= 300 {
+			resp.Diagnostics.AddError(
+				"Failed to read checkpoint region",
+				fmt.Sprintf("HTTP status code: %d with response body %v", statusCode, responseBody),
+			)
+			return
+		}
+
+		count := lo.CountBy(checkpoints.Data, func(c models.Checkpoint) bool {
+			return !lo.Contains(excludedIds, int64(c.Id))
+		})
+		if count < minimumConcurrentRegionCheckpoints {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("selected_checkpoints").AtName("regions"),
+				"Small region with concurrent monitoring",
+				fmt.Sprintf("Region %d only has %d checkpoints. With use_concurrent_monitoring enabled and use_primary_checkpoints_only set to false, "+
+					"concurrent checks may run from too few checkpoints to reach the error thresholds reliably. "+
+					"Consider selecting a larger region or setting use_primary_checkpoints_only to true.", regionId, count),
+			)
+		}
+	}
+}
+
+// validateCredentialVaultItem checks that credential_vault_item_id refers to an existing CredentialSet vault item.
+func (r *monitorResource) validateCredentialVaultItem(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.vaultItemClient == nil {
+		return
+	}
+
+	var vaultItemId types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("credential_vault_item_id"), &vaultItemId)...)
+	if resp.Diagnostics.HasError() || vaultItemId.IsNull() || vaultItemId.IsUnknown() {
+		return
+	}
+
+	vaultItem, err, msg := r.vaultItemClient.GetVaultItem(vaultItemId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("credential_vault_item_id"),
+			"Error reading vault item",
+			fmt.Sprintf("%v - %s", err, msg),
+		)
+		return
+	}
+	if vaultItem.VaultItemType != "CredentialSet" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("credential_vault_item_id"),
+			"Invalid vault item",
+			fmt.Sprintf("Vault item %q is of type %q. Only vault items of type \"CredentialSet\" can be used as monitor credentials.",
+				vaultItemId.ValueString(), vaultItem.VaultItemType),
+		)
+	}
+}
+
+// placeholderSource is an attribute that can contain {{...}} placeholders.
+type placeholderSource struct {
+	path  path.Path
+	value types.String
+}
+
+// validatePlaceholders resolves the {{...}} placeholders in scripts, request headers, request body and URL.
+// Variables must be defined in predefined_variables or by the script itself, and vault items must exist.
+// Built-in placeholders such as {{@RandomGuid}} are not checked.
+func (r *monitorResource) validatePlaceholders(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var config tfsdkmodels.MonitorModelForValidation
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sources := []placeholderSource{
+		{path.Root("url"), config.Url},
+		{path.Root("request_body"), config.RequestBody},
+		{path.Root("self_service_transaction_script"), config.SelfServiceTransactionScript},
+		{path.Root("multi_step_api_transaction_script"), config.MultiStepApiTransactionScript},
+	}
+	allSourcesKnown := !config.RequestHeaders.IsUnknown()
+	if !config.RequestHeaders.IsNull() && !config.RequestHeaders.IsUnknown() {
+		var headers []tfsdkmodels.RequestHeaderModel
+		resp.Diagnostics.Append(config.RequestHeaders.ElementsAs(ctx, &headers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for i, header := range headers {
+			sources = append(sources,
+				placeholderSource{path.Root("request_headers").AtListIndex(i).AtName("name"), header.Name},
+				placeholderSource{path.Root("request_headers").AtListIndex(i).AtName("value"), header.Value},
+			)
+		}
+	}
+
+	// Variables can be defined by the monitor or by its scripts. If any of them is unknown, undefined variables can't be detected.
+	definitionsKnown := !config.PredefinedVariables.IsUnknown() &&
+		!config.SelfServiceTransactionScript.IsUnknown() &&
+		!config.MultiStepApiTransactionScript.IsUnknown()
+	var predefinedVariables []tfsdkmodels.PredefinedVariablesModel
+	if !config.PredefinedVariables.IsNull() && !config.PredefinedVariables.IsUnknown() {
+		resp.Diagnostics.Append(config.PredefinedVariables.ElementsAs(ctx, &predefinedVariables, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	defined := map[string]bool{}
+	for _, variable := range predefinedVariables {
+		if variable.Key.IsUnknown() {
+			definitionsKnown = false
+			continue
+		}
+		defined[variable.Key.ValueString()] = true
+	}
+	if config.PredefinedVariables.IsNull() && !req.State.Raw.IsNull() {
+		// Without predefined_variables in the configuration, the monitor keeps the variables it already has.
+		var planVariables types.List
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("predefined_variables"), &planVariables)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if planVariables.IsUnknown() {
+			definitionsKnown = false
+		} else if !planVariables.IsNull() {
+			var existing []tfsdkmodels.PredefinedVariablesModel
+			resp.Diagnostics.Append(planVariables.ElementsAs(ctx, &existing, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			for _, variable := range existing {
+				defined[variable.Key.ValueString()] = true
+			}
+		}
+	}
+	used := map[string]bool{}
+	for _, script := range []types.String{config.SelfServiceTransactionScript, config.MultiStepApiTransactionScript} {
+		for _, name := range helpers.ScriptVariableNames(script.ValueString()) {
+			defined[name] = true
+		}
+		for _, name := range helpers.ScriptVariableReads(script.ValueString()) {
+			used[name] = true
+		}
+	}
+
+	var vaultItems map[string]bool
+	for _, source := range sources {
+		if source.value.IsUnknown() {
+			allSourcesKnown = false
+			continue
+		}
+		for _, name := range helpers.FindPlaceholders(source.value.ValueString()) {
+			if strings.HasPrefix(name, "@VaultItem.") {
+				if vaultItems == nil {
+					if vaultItems = r.listVaultItemGuids(resp); vaultItems == nil {
+						return
+					}
+				}
+				parts := strings.Split(name, ".")
+				if len(parts) != 3 || parts[2] == "" {
+					resp.Diagnostics.AddAttributeError(source.path, "Invalid vault reference",
+						fmt.Sprintf("Placeholder {{%s}} must have the format {{@VaultItem.<vault item GUID>.<field>}}.", name))
+				} else if !vaultItems[strings.ToLower(parts[1])] {
+					resp.Diagnostics.AddAttributeError(source.path, "Unknown vault item",
+						fmt.Sprintf("Placeholder {{%s}} refers to vault item %q, which doesn't exist.", name, parts[1]))
+				}
+				continue
+			}
+			if strings.HasPrefix(name, "@") {
+				continue
+			}
+			used[name] = true
+			if definitionsKnown && !defined[name] {
+				resp.Diagnostics.AddAttributeError(source.path, "Undefined variable",
+					fmt.Sprintf("Placeholder {{%s}} refers to a variable that isn't defined in predefined_variables or in the script.", name))
+			}
+		}
+	}
+
+	// A Postman collection may define variables of its own, so its placeholders only count as uses.
+	if config.PostmanCollectionJson.IsUnknown() {
+		allSourcesKnown = false
+	}
+	for _, name := range helpers.FindPlaceholders(config.PostmanCollectionJson.ValueString()) {
+		used[name] = true
+	}
+
+	if !allSourcesKnown {
+		return
+	}
+	for i, variable := range predefinedVariables {
+		if variable.Key.IsUnknown() || used[variable.Key.ValueString()] {
+			continue
+		}
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("predefined_variables").AtListIndex(i).AtName("key"),
+			"Unused variable",
+			fmt.Sprintf("Predefined variable %q isn't referenced by any {{%s}} placeholder.", variable.Key.ValueString(), variable.Key.ValueString()),
+		)
+	}
+}
+
+// listVaultItemGuids returns the lowercase GUIDs of all vault items, or nil when they couldn't be listed.
+func (r *monitorResource) listVaultItemGuids(resp *resource.ModifyPlanResponse) map[string]bool {
+	if r.vaultItemClient == nil {
+		return nil
+	}
+	items, statusCode, responseBody, err := r.vaultItemClient.GetVaultItems()
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing vault items", err.Error())
+		return nil
+	}
+	if statusCode >= 300 {
+		resp.Diagnostics.AddError("Failed to list vault items", fmt.Sprintf("HTTP %d: %s", statusCode, responseBody))
+		return nil
+	}
+	guids := make(map[string]bool, len(items))
+	for _, item := range items {
+		guids[strings.ToLower(item.VaultItemGuid)] = true
+	}
+	return guids
+}
+
+func customFieldObjectType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name":  types.StringType,
+			"value": types.StringType,
+		},
+	}
+}
+
+// UpgradeState upgrades monitor state from earlier schema versions.
+func (r *monitorResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 stored custom_fields as a list. Lists and sets share the same JSON representation,
+		// so the raw state can be carried over as is.
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				if req.RawState == nil {
+					resp.Diagnostics.AddError("Unable to upgrade monitor state", "The prior state is missing.")
+					return
+				}
+				resp.DynamicValue = &tfprotov6.DynamicValue{
+					JSON: req.RawState.JSON,
+				}
+			},
+		},
+	}
+}
+
+func (r *monitorResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = guidIdentitySchema()
+}
+
+// ImportState imports a monitor by GUID or by name:<monitor name>.
+// monitorgroup:<monitor group GUID> doesn't import anything, but reports import blocks for every member of the group.
 func (r *monitorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	switch {
+	case strings.HasPrefix(req.ID, "name:"):
+		r.importMonitorByName(ctx, strings.TrimPrefix(req.ID, "name:"), resp)
+	case strings.HasPrefix(req.ID, "monitorgroup:"):
+		r.reportMonitorGroupImportBlocks(strings.TrimPrefix(req.ID, "monitorgroup:"), resp)
+	default:
+		// Retrieve the GUID from the import ID or identity and save to id attribute
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An imported monitor starts out managing all its memberships, so a configuration with monitorgroup_ids
+	// imports without a diff. This reads every monitor group once.
+	var monitorGuid types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &monitorGuid)...)
+	if resp.Diagnostics.HasError() || monitorGuid.IsNull() {
+		return
+	}
+	monitorGroupIds, err := r.readMonitorGroupIds(ctx, monitorGuid.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading monitor group memberships", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("monitorgroup_ids"), monitorGroupIds)...)
+}
+
+func (r *monitorResource) importMonitorByName(ctx context.Context, name string, resp *resource.ImportStateResponse) {
+	if name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected import identifier with format: name:<monitor name>. Got an empty monitor name.",
+		)
+		return
+	}
+
+	monitors, statusCode, responseBody, err := r.client.GetMonitors()
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing monitors", err.Error())
+		return
+	}
+	if statusCode >= 300 {
+		resp.Diagnostics.AddError(
+			"Failed to list monitors",
+			fmt.Sprintf("HTTP status code: %d with response body %v", statusCode, responseBody),
+		)
+		return
+	}
+
+	matches := lo.Filter(monitors, func(m models.MonitorResponse, _ int) bool {
+		return m.Name == name
+	})
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError(
+			"Monitor not found",
+			fmt.Sprintf("No monitor with name %q exists. Monitor names are case-sensitive.", name),
+		)
+		return
+	case 1:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), matches[0].MonitorGuid)...)
+	default:
+		guids := lo.Map(matches, func(m models.MonitorResponse, _ int) string {
+			return m.MonitorGuid
+		})
+		resp.Diagnostics.AddError(
+			"Ambiguous monitor name",
+			fmt.Sprintf("%d monitors are named %q: %s. Import one of them by its GUID instead.", len(matches), name, strings.Join(guids, ", ")),
+		)
+	}
+}
+
+// reportMonitorGroupImportBlocks reports an import block for every monitor in the monitor group.
+// A single import can only bring in one monitor, so the import itself fails.
+func (r *monitorResource) reportMonitorGroupImportBlocks(monitorGroupGuid string, resp *resource.ImportStateResponse) {
+	if monitorGroupGuid == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected import identifier with format: monitorgroup:<monitor group GUID>. Got an empty monitor group GUID.",
+		)
+		return
+	}
+
+	memberships, err := r.membershipClient.GetGroupMemberships(monitorGroupGuid)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading monitor group memberships", err.Error())
+		return
+	}
+	monitors, statusCode, responseBody, err := r.client.GetMonitors()
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing monitors", err.Error())
+		return
+	}
+	if statusCode >= 300 {
+		resp.Diagnostics.AddError(
+			"Failed to list monitors",
+			fmt.Sprintf("HTTP status code: %d with response body %v", statusCode, responseBody),
+		)
+		return
+	}
+	names := lo.SliceToMap(monitors, func(m models.MonitorResponse) (string, string) {
+		return m.MonitorGuid, m.Name
+	})
+
+	members := lo.Uniq(lo.Map(memberships, func(m models.MonitorMembershipResponse, _ int) string {
+		return m.MonitorGuid
+	}))
+	sort.Slice(members, func(i, j int) bool {
+		if names[members[i]] != names[members[j]] {
+			return names[members[i]] < names[members[j]]
+		}
+		return members[i] < members[j]
+	})
+
+	if len(members) == 0 {
+		resp.Diagnostics.AddError(
+			"Monitor group has no members",
+			fmt.Sprintf("Monitor group %q doesn't contain any monitors to import.", monitorGroupGuid),
+		)
+		return
+	}
+
+	used := map[string]bool{}
+	blocks := make([]string, 0, len(members))
+	for _, guid := range members {
+		name := names[guid]
+		if name == "" {
+			name = guid
+		}
+		blocks = append(blocks, fmt.Sprintf("import {\n  to = itrs-uptrends_monitor.%s\n  id = %q\n}", helpers.TerraformResourceName(name, used), guid))
+	}
+
+	resp.Diagnostics.AddError(
+		"Import blocks for monitor group members",
+		fmt.Sprintf("A monitor group can't be imported into a single monitor resource. Add the following import blocks for the %d monitors in monitor group %q to your configuration, "+
+			"then run terraform plan -generate-config-out=generated.tf to generate their configuration.\n\n%s",
+			len(members), monitorGroupGuid, strings.Join(blocks, "\n\n")),
+	)
+}
+
+// readMonitorGroupIds returns the monitor groups the monitor is a member of.
+// The All monitors group is skipped, since every monitor is implicitly a member of it.
+func (r *monitorResource) readMonitorGroupIds(ctx context.Context, monitorGuid string) (types.Set, error) {
+	monitorGroups, _, _, err := r.monitorGroupClient.GetMonitorGroups()
+	if err != nil {
+		return types.SetNull(types.StringType), err
+	}
+
+	monitorGroupIds := []string{}
+	for _, monitorGroup := range monitorGroups {
+		if monitorGroup.IsAll {
+			continue
+		}
+		memberships, err := r.membershipClient.GetGroupMemberships(monitorGroup.MonitorGroupGuid)
+		if err != nil {
+			return types.SetNull(types.StringType), err
+		}
+		if lo.ContainsBy(memberships, func(m models.MonitorMembershipResponse) bool {
+			return m.MonitorGuid == monitorGuid
+		}) {
+			monitorGroupIds = append(monitorGroupIds, monitorGroup.MonitorGroupGuid)
+		}
+	}
+
+	return monitorGroupIdsSet(ctx, monitorGroupIds)
+}
+
+// readManagedMonitorGroupIds returns the monitor groups of managed that the monitor is still a member of.
+// Other memberships are not managed through monitorgroup_ids, so their monitor groups are not read.
+func (r *monitorResource) readManagedMonitorGroupIds(ctx context.Context, monitorGuid string, managed types.Set) (types.Set, error) {
+	var managedIds []string
+	if diags := managed.ElementsAs(ctx, &managedIds, false); diags.HasError() {
+		return types.SetNull(types.StringType), fmt.Errorf("failed to read monitorgroup_ids: %v", diags)
+	}
+
+	monitorGroupIds := []string{}
+	for _, monitorGroupGuid := range managedIds {
+		isMember, err := r.isMonitorGroupMember(monitorGroupGuid, monitorGuid)
+		if err != nil {
+			return types.SetNull(types.StringType), err
+		}
+		if isMember {
+			monitorGroupIds = append(monitorGroupIds, monitorGroupGuid)
+		}
+	}
+	return monitorGroupIdsSet(ctx, monitorGroupIds)
+}
+
+// syncMonitorGroupIds adds the memberships that are in desired but not in prior, and removes the ones that are
+// in prior but not in desired. Memberships in neither set, such as the ones of itrs-uptrends_monitorgroup_membership
+// resources or initial_monitor_group_id_wo, are left alone. A null or unknown desired set means the memberships
+// are not managed by this resource, so nothing is changed.
+// It returns the monitor groups managed after the sync; after an error, these are the changes made so far.
+func (r *monitorResource) syncMonitorGroupIds(ctx context.Context, monitorGuid string, prior, desired types.Set) (types.Set, error) {
+	if desired.IsNull() || desired.IsUnknown() {
+		return types.SetNull(types.StringType), nil
+	}
+
+	var priorIds, desiredIds []string
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if diags := prior.ElementsAs(ctx, &priorIds, false); diags.HasError() {
+			return prior, fmt.Errorf("failed to read monitorgroup_ids: %v", diags)
+		}
+	}
+	if diags := desired.ElementsAs(ctx, &desiredIds, false); diags.HasError() {
+		return prior, fmt.Errorf("failed to read monitorgroup_ids: %v", diags)
+	}
+	toAdd, toRemove := lo.Difference(desiredIds, priorIds)
+
+	// Check the groups to add up front, so an invalid entry doesn't leave the memberships half updated.
+	if len(toAdd) > 0 {
+		monitorGroups, _, _, err := r.monitorGroupClient.GetMonitorGroups()
+		if err != nil {
+			return prior, err
+		}
+		for _, id := range toAdd {
+			monitorGroup, found := lo.Find(monitorGroups, func(g models.MonitorGroupResponse) bool {
+				return g.MonitorGroupGuid == id
+			})
+			if !found {
+				return prior, fmt.Errorf("monitor group %s does not exist", id)
+			}
+			if monitorGroup.IsAll {
+				return prior, fmt.Errorf("monitor group %s is the All monitors group, which cannot be part of monitorgroup_ids", id)
+			}
+		}
+	}
+
+	current := lo.Intersect(priorIds, desiredIds)
+	for i, id := range toRemove {
+		if err := r.membershipClient.DeleteMembership(id, monitorGuid); err != nil {
+			set, _ := monitorGroupIdsSet(ctx, append(current, toRemove[i:]...))
+			return set, err
+		}
+	}
+	for _, id := range toAdd {
+		// The monitor may already be in the group, for example through initial_monitor_group_id_wo.
+		isMember, err := r.isMonitorGroupMember(id, monitorGuid)
+		if err == nil && !isMember {
+			err = r.membershipClient.AssignMembership(id, monitorGuid)
+		}
+		if err != nil {
+			set, _ := monitorGroupIdsSet(ctx, current)
+			return set, err
+		}
+		current = append(current, id)
+	}
+
+	return monitorGroupIdsSet(ctx, current)
+}
+
+// isMonitorGroupMember reports whether the monitor is a member of the monitor group.
+func (r *monitorResource) isMonitorGroupMember(monitorGroupGuid, monitorGuid string) (bool, error) {
+	memberships, err := r.membershipClient.GetGroupMemberships(monitorGroupGuid)
+	if err != nil {
+		return false, err
+	}
+	return lo.ContainsBy(memberships, func(m models.MonitorMembershipResponse) bool {
+		return m.MonitorGuid == monitorGuid
+	}), nil
+}
+
+func monitorGroupIdsSet(ctx context.Context, monitorGroupIds []string) (types.Set, error) {
+	set, diags := types.SetValueFrom(ctx, types.StringType, monitorGroupIds)
+	if diags.HasError() {
+		return types.SetNull(types.StringType), fmt.Errorf("failed to convert monitor group ids: %v", diags)
+	}
+	return set, nil
 }
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	interfaces "github.com/itrs-group/terraform-provider-itrs-uptrends/client/interfaces"
+)
+
+var _ list.ListResource = &monitorGroupListResource{}
+
+// NewMonitorGroupListResource constructs the list resource for monitor groups.
+func NewMonitorGroupListResource(client interfaces.IMonitorGroupClient) list.ListResource {
+	return &monitorGroupListResource{client: client}
+}
+
+type monitorGroupListResource struct {
+	client interfaces.IMonitorGroupClient
+}
+
+type monitorGroupListConfigModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+}
+
+func (r *monitorGroupListResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "itrs-uptrends_monitorgroup"
+}
+
+func (r *monitorGroupListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists the monitor groups in the account, except the built-in \"All monitors\" group.",
+		Attributes: map[string]listschema.Attribute{
+			"name_regex": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list monitor groups whose description matches this regular expression.",
+			},
+		},
+	}
+}
+
+func (r *monitorGroupListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config monitorGroupListConfigModel
+	diags := req.Config.Get(ctx, &config)
+	nameRegex, regexDiags := compileNameRegex(config.NameRegex)
+	diags.Append(regexDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	monitorGroups, statusCode, responseBody, err := r.client.GetMonitorGroups()
+	if err != nil {
+		diags.AddError("Error listing monitor groups", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	if statusCode >= 300 {
+		diags.AddError(
+			"Failed to list monitor groups",
+			fmt.Sprintf("HTTP status code: %d with response body %v", statusCode, responseBody),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	results := make([]list.ListResult, 0, len(monitorGroups))
+	for _, monitorGroup := range monitorGroups {
+		if monitorGroup.IsAll || (nameRegex != nil && !nameRegex.MatchString(monitorGroup.Description)) {
+			continue
+		}
+		result := newGuidListResult(ctx, req, monitorGroup.MonitorGroupGuid, monitorGroup.Description)
+		if req.IncludeResource {
+			state := monitorGroupState(monitorGroup)
+			result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+		}
+		results = append(results, result)
+	}
+	streamListResults(req, results, stream)
+}
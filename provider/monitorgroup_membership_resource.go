@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -32,6 +33,23 @@ type monitorgroupMembershipResourceModel struct {
 	MonitorGroupID types.String `tfsdk:"monitorgroup_id"`
 }
 
+// monitorgroupMembershipIdentityModel is the resource identity of itrs-uptrends_monitorgroup_membership.
+type monitorgroupMembershipIdentityModel struct {
+	MonitorID      types.String `tfsdk:"monitor_id"`
+	MonitorGroupID types.String `tfsdk:"monitorgroup_id"`
+}
+
+func (i monitorgroupMembershipIdentityModel) importID() string {
+	return fmt.Sprintf("%s:%s", i.MonitorID.ValueString(), i.MonitorGroupID.ValueString())
+}
+
+func (m monitorgroupMembershipResourceModel) identity() monitorgroupMembershipIdentityModel {
+	return monitorgroupMembershipIdentityModel{
+		MonitorID:      m.MonitorID,
+		MonitorGroupID: m.MonitorGroupID,
+	}
+}
+
 // NewMonitorgroupMembershipResource instantiates the resource.
 func NewMonitorgroupMembershipResource(client interfaces.IMonitorGroupMember) resource.Resource {
 	return &monitorgroupMembershipResource{
@@ -104,6 +122,7 @@ func (r *monitorgroupMembershipResource) Create(ctx context.Context, req resourc
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, plan.identity())...)
 }
 
 // Read refreshes the Terraform state with data from the API.
@@ -114,6 +133,7 @@ func (r *monitorgroupMembershipResource) Read(ctx context.Context, req resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, state.identity())...)
 
 	// Retrieve memberships for the monitor group.
 	memberships, err := r.client.GetGroupMemberships(state.MonitorGroupID.ValueString())
@@ -160,14 +180,36 @@ func (r *monitorgroupMembershipResource) Delete(ctx context.Context, req resourc
 	}
 }
 
+func (r *monitorgroupMembershipResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"monitor_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "GUID of the monitor.",
+			},
+			"monitorgroup_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "GUID of the monitor group.",
+			},
+		},
+	}
+}
+
 func (r *monitorgroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity monitorgroupMembershipIdentityModel
+	importID, diags := importStateID(ctx, req, &identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Split the import ID into monitor_id and monitorgroup_id
-	idParts := strings.Split(req.ID, ":")
+	idParts := strings.Split(importID, ":")
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: monitor_id:monitorgroup_id. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: monitor_id:monitorgroup_id. Got: %q", importID),
 		)
 		return
 	}
@@ -177,5 +219,5 @@ func (r *monitorgroupMembershipResource) ImportState(ctx context.Context, req re
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("monitorgroup_id"), idParts[1])...)
 
 	// Set the ID attribute in the state
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importID)...)
 }
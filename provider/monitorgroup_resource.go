@@ -211,48 +211,11 @@ func (r *monitorGroupResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	var state monitorGroupResourceModel
-	state.ID = types.StringValue(result.MonitorGroupGuid)
-	state.Description = types.StringValue(result.Description)
-	if result.IsQuotaUnlimited != nil {
-		state.IsQuotaUnlimited = types.BoolValue(bool(*result.IsQuotaUnlimited))
-	} else {
-		state.IsQuotaUnlimited = types.BoolNull()
-	}
-	state.IsAll = types.BoolValue(result.IsAll)
-	if result.BasicMonitorQuota != nil {
-		state.BasicMonitorQuota = types.Int64Value(int64(*result.BasicMonitorQuota))
-	} else {
-		state.BasicMonitorQuota = types.Int64Null()
-	}
-	if result.BrowserMonitorQuota != nil {
-		state.BrowserMonitorQuota = types.Int64Value(int64(*result.BrowserMonitorQuota))
-	} else {
-		state.BrowserMonitorQuota = types.Int64Null()
-	}
-	if result.TransactionMonitorQuota != nil {
-		state.TransactionMonitorQuota = types.Int64Value(int64(*result.TransactionMonitorQuota))
-	} else {
-		state.TransactionMonitorQuota = types.Int64Null()
-	}
-	if result.ApiMonitorQuota != nil {
-		state.ApiMonitorQuota = types.Int64Value(int64(*result.ApiMonitorQuota))
-	} else {
-		state.ApiMonitorQuota = types.Int64Null()
-	}
-	if result.UnifiedCreditsQuota != nil {
-		state.UnifiedCreditsQuota = types.Int64Value(int64(*result.UnifiedCreditsQuota))
-	} else {
-		state.UnifiedCreditsQuota = types.Int64Null()
-	}
-	if result.ClassicQuota != nil {
-		state.ClassicQuota = types.Int64Value(int64(*result.ClassicQuota))
-	} else {
-		state.ClassicQuota = types.Int64Null()
-	}
+	state := monitorGroupState(result)
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 // Read refreshes the Terraform state with the latest resource data.
@@ -263,6 +226,7 @@ func (r *monitorGroupResource) Read(ctx context.Context, req resource.ReadReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, state.ID)...)
 
 	result, _, err := r.client.GetMonitorGroup(state.ID.ValueString())
 	if err != nil {
@@ -270,6 +234,15 @@ func (r *monitorGroupResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	state = monitorGroupState(result)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// monitorGroupState maps a monitor group returned by the API to the resource model.
+func monitorGroupState(result models.MonitorGroupResponse) monitorGroupResourceModel {
+	var state monitorGroupResourceModel
 	state.ID = types.StringValue(result.MonitorGroupGuid)
 	state.Description = types.StringValue(result.Description)
 	if result.IsQuotaUnlimited != nil {
@@ -308,9 +281,7 @@ func (r *monitorGroupResource) Read(ctx context.Context, req resource.ReadReques
 	} else {
 		state.ClassicQuota = types.Int64Null()
 	}
-
-	diags = resp.State.Set(ctx, &state)
-	resp.Diagnostics.Append(diags...)
+	return state
 }
 
 // Update applies changes to the existing resource.
@@ -412,6 +383,7 @@ func (r *monitorGroupResource) Update(ctx context.Context, req resource.UpdateRe
 	// Persist the refreshed state.
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 // Delete removes the resource.
@@ -439,7 +411,11 @@ func (r *monitorGroupResource) Delete(ctx context.Context, req resource.DeleteRe
 	resp.State.RemoveResource(ctx)
 }
 
+func (r *monitorGroupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = guidIdentitySchema()
+}
+
 func (r *monitorGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	interfaces "github.com/itrs-group/terraform-provider-itrs-uptrends/client/interfaces"
+)
+
+var _ datasource.DataSource = &monitorsDataSource{}
+
+// NewMonitorsDataSource constructs the plural monitors data source.
+func NewMonitorsDataSource(monitor interfaces.IMonitor, membership interfaces.IMonitorGroupMember) datasource.DataSource {
+	return &monitorsDataSource{client: monitor, membershipClient: membership}
+}
+
+type monitorsDataSource struct {
+	client           interfaces.IMonitor
+	membershipClient interfaces.IMonitorGroupMember
+}
+
+type monitorSummaryModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	MonitorType          types.String `tfsdk:"monitor_type"`
+	MonitorMode          types.String `tfsdk:"monitor_mode"`
+	IsActive             types.Bool   `tfsdk:"is_active"`
+	GenerateAlert        types.Bool   `tfsdk:"generate_alert"`
+	CheckInterval        types.Int64  `tfsdk:"check_interval"`
+	CheckIntervalSeconds types.Int64  `tfsdk:"check_interval_seconds"`
+	Url                  types.String `tfsdk:"url"`
+	NetworkAddress       types.String `tfsdk:"network_address"`
+}
+
+type monitorsDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	MonitorType      types.String `tfsdk:"monitor_type"`
+	NameRegex        types.String `tfsdk:"name_regex"`
+	MonitorMode      types.String `tfsdk:"monitor_mode"`
+	IsActive         types.Bool   `tfsdk:"is_active"`
+	CustomFieldName  types.String `tfsdk:"custom_field_name"`
+	CustomFieldValue types.String `tfsdk:"custom_field_value"`
+	MonitorGroupID   types.String `tfsdk:"monitorgroup_id"`
+	IDs              types.List   `tfsdk:"ids"`
+	Monitors         types.List   `tfsdk:"monitors"`
+}
+
+func (d *monitorsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitors"
+}
+
+func (d *monitorsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Internal identifier for this data source instance.",
+			},
+			"monitor_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return monitors of this monitor type, e.g. Https.",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return monitors whose name matches this regular expression.",
+			},
+			"monitor_mode": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return monitors in this monitor mode.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"Development",
+						"Staging",
+						"Production",
+					),
+				},
+			},
+			"is_active": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return active (true) or inactive (false) monitors.",
+			},
+			"custom_field_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return monitors that have a custom field with this name.",
+			},
+			"custom_field_value": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return monitors whose custom field named custom_field_name has this value.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("custom_field_name")),
+				},
+			},
+			"monitorgroup_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return monitors that are a member of this monitor group.",
+			},
+			"ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "GUIDs of the matching monitors, sorted by monitor name.",
+			},
+			"monitors": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Summary of each matching monitor, sorted by monitor name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":                     schema.StringAttribute{Computed: true},
+						"name":                   schema.StringAttribute{Computed: true},
+						"monitor_type":           schema.StringAttribute{Computed: true},
+						"monitor_mode":           schema.StringAttribute{Computed: true},
+						"is_active":              schema.BoolAttribute{Computed: true},
+						"generate_alert":         schema.BoolAttribute{Computed: true},
+						"check_interval":         schema.Int64Attribute{Computed: true},
+						"check_interval_seconds": schema.Int64Attribute{Computed: true},
+						"url":                    schema.StringAttribute{Computed: true},
+						"network_address":        schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *monitorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The monitor client was not configured. This is an internal error in the provider.")
+		return
+	}
+
+	var data monitorsDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitors, statusCode, responseBody, err := d.client.GetMonitors()
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing monitors", err.Error())
+		return
+	}
+	if statusCode >= 300 {
+		resp.Diagnostics.AddError(
+			"Failed to list monitors",
+			fmt.Sprintf("HTTP status code: %d with response body %v", statusCode, responseBody),
+		)
+		return
+	}
+
+	matches, diags := filterMonitors(monitors, monitorFilterModel{
+		MonitorType:      data.MonitorType,
+		NameRegex:        data.NameRegex,
+		MonitorMode:      data.MonitorMode,
+		IsActive:         data.IsActive,
+		CustomFieldName:  data.CustomFieldName,
+		CustomFieldValue: data.CustomFieldValue,
+		MonitorGroupID:   data.MonitorGroupID,
+	}, d.membershipClient)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := make([]string, 0, len(matches))
+	summaries := make([]monitorSummaryModel, 0, len(matches))
+	for _, m := range matches {
+		ids = append(ids, m.MonitorGuid)
+		summaries = append(summaries, monitorSummaryModel{
+			ID:                   types.StringValue(m.MonitorGuid),
+			Name:                 types.StringValue(m.Name),
+			MonitorType:          types.StringValue(m.MonitorType),
+			MonitorMode:          types.StringValue(m.MonitorMode),
+			IsActive:             types.BoolValue(m.IsActive),
+			GenerateAlert:        types.BoolValue(m.GenerateAlert),
+			CheckInterval:        int64PointerValue(m.CheckInterval),
+			CheckIntervalSeconds: int64PointerValue(m.CheckIntervalSeconds),
+			Url:                  types.StringPointerValue(m.Url),
+			NetworkAddress:       types.StringPointerValue(m.NetworkAddress),
+		})
+	}
+
+	idsVal, diag := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorsVal, diag := types.ListValueFrom(ctx, monitorSummaryModelType(), summaries)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue("monitors_data_source")
+	data.IDs = idsVal
+	data.Monitors = monitorsVal
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func monitorSummaryModelType() attr.Type {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":                     types.StringType,
+			"name":                   types.StringType,
+			"monitor_type":           types.StringType,
+			"monitor_mode":           types.StringType,
+			"is_active":              types.BoolType,
+			"generate_alert":         types.BoolType,
+			"check_interval":         types.Int64Type,
+			"check_interval_seconds": types.Int64Type,
+			"url":                    types.StringType,
+			"network_address":        types.StringType,
+		},
+	}
+}
+
+func int64PointerValue(v *int) types.Int64 {
+	if v == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*v))
+}
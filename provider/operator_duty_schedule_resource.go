@@ -0,0 +1,444 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	interfaces "github.com/itrs-group/terraform-provider-itrs-uptrends/client/interfaces"
+	models "github.com/itrs-group/terraform-provider-itrs-uptrends/client/models"
+	"github.com/itrs-group/terraform-provider-itrs-uptrends/helpers"
+)
+
+// weeklyRecurrence is the recurrence of the duty schedules managed by itrs-uptrends_operator_duty_schedule.
+const weeklyRecurrence = "Weekly"
+
+var (
+	_ resource.Resource                   = &operatorDutyScheduleResource{}
+	_ resource.ResourceWithValidateConfig = &operatorDutyScheduleResource{}
+	_ resource.ResourceWithImportState    = &operatorDutyScheduleResource{}
+	_ resource.ResourceWithIdentity       = &operatorDutyScheduleResource{}
+)
+
+var dutyTimeRegex = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+var weekdays = []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
+
+type operatorDutyScheduleResource struct {
+	client interfaces.IOperator
+}
+
+func NewOperatorDutyScheduleResource(client interfaces.IOperator) resource.Resource {
+	return &operatorDutyScheduleResource{
+		client: client,
+	}
+}
+
+type operatorDutyScheduleModel struct {
+	ID         types.String `tfsdk:"id"`
+	OperatorID types.String `tfsdk:"operator_id"`
+	TimeZoneID types.Int64  `tfsdk:"time_zone_id"`
+	Periods    types.Set    `tfsdk:"period"`
+}
+
+type dutyPeriodModel struct {
+	StartDay  types.String `tfsdk:"start_day"`
+	StartTime types.String `tfsdk:"start_time"`
+	EndDay    types.String `tfsdk:"end_day"`
+	EndTime   types.String `tfsdk:"end_time"`
+}
+
+// operatorDutyScheduleIdentityModel is the resource identity of itrs-uptrends_operator_duty_schedule.
+type operatorDutyScheduleIdentityModel struct {
+	OperatorID types.String `tfsdk:"operator_id"`
+}
+
+func (i operatorDutyScheduleIdentityModel) importID() string {
+	return i.OperatorID.ValueString()
+}
+
+func dutyPeriodModelType() attr.Type {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"start_day":  types.StringType,
+			"start_time": types.StringType,
+			"end_day":    types.StringType,
+			"end_time":   types.StringType,
+		},
+	}
+}
+
+func (r *operatorDutyScheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "itrs-uptrends_operator_duty_schedule"
+}
+
+func (r *operatorDutyScheduleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	dayValidators := []validator.String{stringvalidator.OneOf(weekdays...)}
+	timeValidators := []validator.String{stringvalidator.RegexMatches(dutyTimeRegex, "must be a time in the HH:MM format, from 00:00 to 23:59")}
+
+	resp.Schema = rschema.Schema{
+		Description: "Manages the weekly duty periods of an operator. Days and times are in the time zone of the operator.",
+		Attributes: map[string]rschema.Attribute{
+			"id": rschema.StringAttribute{
+				Computed:    true,
+				Description: "The GUID of the operator.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"operator_id": rschema.StringAttribute{
+				Required:    true,
+				Description: "The GUID of the operator.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"time_zone_id": rschema.Int64Attribute{
+				Computed:    true,
+				Description: "The time zone ID of the operator, in which the days and times of the periods are interpreted.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"period": rschema.SetNestedAttribute{
+				Required:    true,
+				Description: "Weekly periods in which the operator is on duty. Periods may not overlap. A period whose end lies before its start runs through the end of the week.",
+				NestedObject: rschema.NestedAttributeObject{
+					Attributes: map[string]rschema.Attribute{
+						"start_day": rschema.StringAttribute{
+							Required:    true,
+							Description: "Day the period starts. Valid values: `Monday` to `Sunday`.",
+							Validators:  dayValidators,
+						},
+						"start_time": rschema.StringAttribute{
+							Required:    true,
+							Description: "Time the period starts, in the HH:MM format.",
+							Validators:  timeValidators,
+						},
+						"end_day": rschema.StringAttribute{
+							Required:    true,
+							Description: "Day the period ends. Valid values: `Monday` to `Sunday`.",
+							Validators:  dayValidators,
+						},
+						"end_time": rschema.StringAttribute{
+							Required:    true,
+							Description: "Time the period ends, in the HH:MM format.",
+							Validators:  timeValidators,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *operatorDutyScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config operatorDutyScheduleModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Periods.IsNull() || config.Periods.IsUnknown() {
+		return
+	}
+
+	var periods []dutyPeriodModel
+	resp.Diagnostics.Append(config.Periods.ElementsAs(ctx, &periods, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dutyPeriods := make([]helpers.DutyPeriod, 0, len(periods))
+	for _, period := range periods {
+		if period.StartDay.IsUnknown() || period.StartTime.IsUnknown() || period.EndDay.IsUnknown() || period.EndTime.IsUnknown() {
+			continue
+		}
+		// Malformed days and times are reported by the attribute validators.
+		if !slices.Contains(weekdays, period.StartDay.ValueString()) || !slices.Contains(weekdays, period.EndDay.ValueString()) ||
+			!dutyTimeRegex.MatchString(period.StartTime.ValueString()) || !dutyTimeRegex.MatchString(period.EndTime.ValueString()) {
+			continue
+		}
+		dutyPeriods = append(dutyPeriods, period.dutyPeriod())
+	}
+
+	for _, err := range helpers.ValidateDutyPeriods(dutyPeriods) {
+		resp.Diagnostics.AddAttributeError(path.Root("period"), "Invalid duty period", err.Error())
+	}
+}
+
+func (m dutyPeriodModel) dutyPeriod() helpers.DutyPeriod {
+	return helpers.DutyPeriod{
+		StartDay:  m.StartDay.ValueString(),
+		StartTime: m.StartTime.ValueString(),
+		EndDay:    m.EndDay.ValueString(),
+		EndTime:   m.EndTime.ValueString(),
+	}
+}
+
+func (r *operatorDutyScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan operatorDutyScheduleModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operatorID := plan.OperatorID.ValueString()
+	_, statusCode, responseBody, err := r.client.GetOperator(operatorID)
+	if statusCode == http.StatusNotFound {
+		resp.Diagnostics.AddAttributeError(path.Root("operator_id"), "Operator not found", fmt.Sprintf("No operator found with GUID %q.", operatorID))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading operator", fmt.Sprintf("%s: %s", err.Error(), responseBody))
+		return
+	}
+
+	r.applyPeriods(ctx, operatorID, plan.Periods, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, found := r.readDutySchedule(ctx, operatorID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Operator not found", fmt.Sprintf("The operator %q was removed while its duty schedule was created.", operatorID))
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, operatorDutyScheduleIdentityModel{OperatorID: state.OperatorID})...)
+}
+
+func (r *operatorDutyScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state operatorDutyScheduleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, operatorDutyScheduleIdentityModel{OperatorID: state.OperatorID})...)
+
+	refreshed, found := r.readDutySchedule(ctx, state.OperatorID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshed)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *operatorDutyScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan operatorDutyScheduleModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operatorID := plan.OperatorID.ValueString()
+	r.applyPeriods(ctx, operatorID, plan.Periods, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, found := r.readDutySchedule(ctx, operatorID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Operator not found", fmt.Sprintf("The operator %q was removed while its duty schedule was updated.", operatorID))
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, operatorDutyScheduleIdentityModel{OperatorID: state.OperatorID})...)
+}
+
+func (r *operatorDutyScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state operatorDutyScheduleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyPeriods(ctx, state.OperatorID.ValueString(), types.SetNull(dutyPeriodModelType()), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// applyPeriods makes the weekly duty schedules of the operator match periods. Schedules that are already
+// in place are kept, the others are deleted, and missing periods are created. Schedules with another
+// recurrence are left alone.
+func (r *operatorDutyScheduleResource) applyPeriods(ctx context.Context, operatorID string, periods types.Set, diags *diag.Diagnostics) {
+	var planned []dutyPeriodModel
+	if !periods.IsNull() {
+		diags.Append(periods.ElementsAs(ctx, &planned, false)...)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	wanted := map[string]helpers.DutyPeriod{}
+	for _, period := range planned {
+		dutyPeriod := period.dutyPeriod()
+		wanted[dutyPeriodKey(dutyPeriod)] = dutyPeriod
+	}
+
+	schedules, statusCode, responseBody, err := r.client.GetDutySchedules(operatorID)
+	if statusCode == http.StatusNotFound && len(wanted) == 0 {
+		return
+	}
+	if err != nil {
+		diags.AddError("Error reading duty schedules", fmt.Sprintf("%s: %s", err.Error(), responseBody))
+		return
+	}
+
+	for _, schedule := range schedules {
+		if !strings.EqualFold(schedule.Recurrence, weeklyRecurrence) {
+			continue
+		}
+		key := dutyPeriodKey(scheduleDutyPeriod(schedule))
+		if _, ok := wanted[key]; ok {
+			delete(wanted, key)
+			continue
+		}
+
+		statusCode, responseBody, err := r.client.DeleteDutySchedule(operatorID, schedule.Id)
+		if statusCode == http.StatusNotFound {
+			continue
+		}
+		if err != nil {
+			diags.AddError("Error deleting duty schedule", fmt.Sprintf("%s: %s", err.Error(), responseBody))
+			return
+		}
+	}
+
+	for _, period := range wanted {
+		_, _, responseBody, err := r.client.CreateDutySchedule(operatorID, models.OperatorDutySchedule{
+			Recurrence: weeklyRecurrence,
+			StartDay:   period.StartDay,
+			StartTime:  period.StartTime,
+			EndDay:     period.EndDay,
+			EndTime:    period.EndTime,
+		})
+		if err != nil {
+			diags.AddError("Error creating duty schedule", fmt.Sprintf("%s: %s", err.Error(), responseBody))
+			return
+		}
+	}
+}
+
+// readDutySchedule reads the time zone and the weekly duty schedules of the operator.
+// It returns false when the operator no longer exists.
+func (r *operatorDutyScheduleResource) readDutySchedule(ctx context.Context, operatorID string, diags *diag.Diagnostics) (operatorDutyScheduleModel, bool) {
+	operator, statusCode, responseBody, err := r.client.GetOperator(operatorID)
+	if statusCode == http.StatusNotFound {
+		return operatorDutyScheduleModel{}, false
+	}
+	if err != nil {
+		diags.AddError("Error reading operator", fmt.Sprintf("%s: %s", err.Error(), responseBody))
+		return operatorDutyScheduleModel{}, false
+	}
+
+	schedules, statusCode, responseBody, err := r.client.GetDutySchedules(operatorID)
+	if statusCode == http.StatusNotFound {
+		return operatorDutyScheduleModel{}, false
+	}
+	if err != nil {
+		diags.AddError("Error reading duty schedules", fmt.Sprintf("%s: %s", err.Error(), responseBody))
+		return operatorDutyScheduleModel{}, false
+	}
+
+	periods := []dutyPeriodModel{}
+	for _, schedule := range schedules {
+		if !strings.EqualFold(schedule.Recurrence, weeklyRecurrence) {
+			continue
+		}
+		period := scheduleDutyPeriod(schedule)
+		periods = append(periods, dutyPeriodModel{
+			StartDay:  types.StringValue(period.StartDay),
+			StartTime: types.StringValue(period.StartTime),
+			EndDay:    types.StringValue(period.EndDay),
+			EndTime:   types.StringValue(period.EndTime),
+		})
+	}
+
+	periodsVal, d := types.SetValueFrom(ctx, dutyPeriodModelType(), periods)
+	diags.Append(d...)
+
+	return operatorDutyScheduleModel{
+		ID:         types.StringValue(operatorID),
+		OperatorID: types.StringValue(operatorID),
+		TimeZoneID: types.Int64Value(int64(operator.TimeZoneId)),
+		Periods:    periodsVal,
+	}, true
+}
+
+// scheduleDutyPeriod converts a duty schedule of the API to a duty period, dropping the seconds the API may add to times.
+func scheduleDutyPeriod(schedule models.OperatorDutySchedule) helpers.DutyPeriod {
+	return helpers.DutyPeriod{
+		StartDay:  schedule.StartDay,
+		StartTime: trimSeconds(schedule.StartTime),
+		EndDay:    schedule.EndDay,
+		EndTime:   trimSeconds(schedule.EndTime),
+	}
+}
+
+func trimSeconds(clock string) string {
+	if len(clock) == len("15:04:05") {
+		return clock[:len("15:04")]
+	}
+	return clock
+}
+
+func dutyPeriodKey(period helpers.DutyPeriod) string {
+	return strings.ToLower(period.String())
+}
+
+func (r *operatorDutyScheduleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"operator_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "GUID of the operator.",
+			},
+		},
+	}
+}
+
+func (r *operatorDutyScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity operatorDutyScheduleIdentityModel
+	operatorID, diags := importStateID(ctx, req, &identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), operatorID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("operator_id"), operatorID)...)
+}
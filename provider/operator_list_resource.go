@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	interfaces "github.com/itrs-group/terraform-provider-itrs-uptrends/client/interfaces"
+)
+
+var _ list.ListResource = &operatorListResource{}
+
+// NewOperatorListResource constructs the list resource for operators.
+func NewOperatorListResource(client interfaces.IOperator) list.ListResource {
+	return &operatorListResource{client: client}
+}
+
+type operatorListResource struct {
+	client interfaces.IOperator
+}
+
+type operatorListConfigModel struct {
+	NameRegex    types.String `tfsdk:"name_regex"`
+	OperatorRole types.String `tfsdk:"operator_role"`
+}
+
+func (r *operatorListResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "itrs-uptrends_operator"
+}
+
+func (r *operatorListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists the operators in the account, optionally filtered.",
+		Attributes: map[string]listschema.Attribute{
+			"name_regex": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list operators whose full name matches this regular expression.",
+			},
+			"operator_role": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list operators with this operator role.",
+			},
+		},
+	}
+}
+
+func (r *operatorListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config operatorListConfigModel
+	diags := req.Config.Get(ctx, &config)
+	nameRegex, regexDiags := compileNameRegex(config.NameRegex)
+	diags.Append(regexDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	operators, statusCode, responseBody, err := r.client.GetOperators()
+	if err != nil {
+		diags.AddError("Error listing operators", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	if statusCode >= 300 {
+		diags.AddError(
+			"Failed to list operators",
+			fmt.Sprintf("HTTP status code: %d with response body %v", statusCode, responseBody),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	results := make([]list.ListResult, 0, len(operators))
+	for i := range operators {
+		operator := &operators[i]
+		if nameRegex != nil && !nameRegex.MatchString(operator.FullName) {
+			continue
+		}
+		if !config.OperatorRole.IsNull() && operator.OperatorRole != config.OperatorRole.ValueString() {
+			continue
+		}
+		result := newGuidListResult(ctx, req, operator.OperatorGuid, operator.FullName)
+		if req.IncludeResource {
+			state := operatorResourceModel{ID: types.StringValue(operator.OperatorGuid)}
+			updateOperatorState(&state, operator)
+			result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+		}
+		results = append(results, result)
+	}
+	streamListResults(req, results, stream)
+}
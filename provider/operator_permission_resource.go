@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -39,6 +40,23 @@ type operatorPermissionModel struct {
 	Permission types.String `tfsdk:"permission"`
 }
 
+// operatorPermissionIdentityModel is the resource identity of itrs-uptrends_operator_permission.
+type operatorPermissionIdentityModel struct {
+	OperatorID types.String `tfsdk:"operator_id"`
+	Permission types.String `tfsdk:"permission"`
+}
+
+func (i operatorPermissionIdentityModel) importID() string {
+	return fmt.Sprintf("%s:%s", i.OperatorID.ValueString(), i.Permission.ValueString())
+}
+
+func (m operatorPermissionModel) identity() operatorPermissionIdentityModel {
+	return operatorPermissionIdentityModel{
+		OperatorID: m.OperatorID,
+		Permission: m.Permission,
+	}
+}
+
 // Metadata returns the resource type name.
 func (r *operatorPermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = "itrs-uptrends_operator_permission"
@@ -92,6 +110,7 @@ func (r *operatorPermissionResource) Read(ctx context.Context, req resource.Read
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, state.identity())...)
 
 	// Retrieve the current permission from the API.
 	currentPermission, err := r.client.GetOperatorPermission(state.OperatorID.ValueString())
@@ -146,6 +165,7 @@ func (r *operatorPermissionResource) Create(ctx context.Context, req resource.Cr
 	// Save the state.
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, plan.identity())...)
 }
 
 func (r *operatorPermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -174,9 +194,31 @@ func (r *operatorPermissionResource) Delete(ctx context.Context, req resource.De
 	resp.State.RemoveResource(ctx)
 }
 
+func (r *operatorPermissionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"operator_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "GUID of the operator.",
+			},
+			"permission": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Name of the permission.",
+			},
+		},
+	}
+}
+
 func (r *operatorPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity operatorPermissionIdentityModel
+	importID, diags := importStateID(ctx, req, &identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Parse the composite ID.
-	parts := strings.Split(req.ID, ":")
+	parts := strings.Split(importID, ":")
 	if len(parts) != 2 {
 		resp.Diagnostics.AddError(
 			"Error importing resource",
@@ -191,5 +233,5 @@ func (r *operatorPermissionResource) ImportState(ctx context.Context, req resour
 	// Set the attributes in the state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("operator_id"), operatorID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permission"), permission)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importID)...)
 }
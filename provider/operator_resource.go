@@ -377,6 +377,7 @@ func (r *operatorResource) Create(
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, state.ID)...)
 	resp.Diagnostics.AddWarning(
 		"When you create an operator, it has a default authorization of 'AccountAccess'. Import the following itrs-uptrends_operator_authorization resource to manage this.",
 		fmt.Sprintf(`import {
@@ -399,6 +400,7 @@ func (r *operatorResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, state.ID)...)
 
 	operatorID := state.ID.ValueString()
 
@@ -420,6 +422,15 @@ func (r *operatorResource) Read(
 		return
 	}
 
+	updateOperatorState(&state, operator)
+	state.PasswordVersion = passwordVersion
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// updateOperatorState copies the attributes of an operator returned by the API to the resource model.
+func updateOperatorState(state *operatorResourceModel, operator *models.OperatorResponse) {
 	state.FullName = types.StringValue(operator.FullName)
 	state.Email = types.StringValue(operator.Email)
 	state.MobilePhone = types.StringValue(operator.MobilePhone)
@@ -430,15 +441,11 @@ func (r *operatorResource) Read(
 	state.TimeZoneId = types.Int64Value(int64(operator.TimeZoneId))
 	state.SmsProvider = types.StringValue(operator.SmsProvider)
 	state.DefaultDashboard = types.StringValue(operator.DefaultDashboard)
-	state.PasswordVersion = passwordVersion
 	role := operator.OperatorRole
 	if role == "" {
 		role = "Unspecified"
 	}
 	state.OperatorRole = types.StringValue(role)
-
-	diags = resp.State.Set(ctx, &state)
-	resp.Diagnostics.Append(diags...)
 }
 
 // Update modifies the operator by calling UpdateOperator.
@@ -573,6 +580,7 @@ func (r *operatorResource) Update(
 	}
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 // Delete removes the operator by calling DeleteOperator and then clears state.
@@ -606,9 +614,13 @@ func (r *operatorResource) Delete(
 	resp.State.RemoveResource(ctx)
 }
 
+func (r *operatorResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = guidIdentitySchema()
+}
+
 func (r *operatorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // providerData is an example struct representing data stored in the provider.
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -39,6 +40,23 @@ type membershipModel struct {
 	GroupID    types.String `tfsdk:"operatorgroup_id"`
 }
 
+// membershipIdentityModel is the resource identity of itrs-uptrends_operatorgroup_membership.
+type membershipIdentityModel struct {
+	OperatorID types.String `tfsdk:"operator_id"`
+	GroupID    types.String `tfsdk:"operatorgroup_id"`
+}
+
+func (i membershipIdentityModel) importID() string {
+	return fmt.Sprintf("%s:%s", i.OperatorID.ValueString(), i.GroupID.ValueString())
+}
+
+func (m membershipModel) identity() membershipIdentityModel {
+	return membershipIdentityModel{
+		OperatorID: m.OperatorID,
+		GroupID:    m.GroupID,
+	}
+}
+
 // Metadata returns the resource type name.
 func (r *membershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = "itrs-uptrends_operatorgroup_membership"
@@ -94,6 +112,7 @@ func (r *membershipResource) Create(ctx context.Context, req resource.CreateRequ
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, plan.identity())...)
 }
 
 // Read refreshes the state of the membership resource.
@@ -104,6 +123,7 @@ func (r *membershipResource) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, state.identity())...)
 
 	// Retrieve operatorgroup_memberships for the given group.
 	memberships, err := r.client.GetMemberships(state.GroupID.ValueString())
@@ -152,14 +172,36 @@ func (r *membershipResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 }
 
+func (r *membershipResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"operator_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "GUID of the operator.",
+			},
+			"operatorgroup_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "GUID of the operator group.",
+			},
+		},
+	}
+}
+
 func (r *membershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity membershipIdentityModel
+	importID, diags := importStateID(ctx, req, &identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Split the import ID into operator_id and group_id
-	idParts := strings.Split(req.ID, ":")
+	idParts := strings.Split(importID, ":")
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: operator_id:operatorgroup_id. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: operator_id:operatorgroup_id. Got: %q", importID),
 		)
 		return
 	}
@@ -169,5 +211,5 @@ func (r *membershipResource) ImportState(ctx context.Context, req resource.Impor
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("operatorgroup_id"), idParts[1])...)
 
 	// Set the ID attribute in the state
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importID)...)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -38,6 +39,23 @@ type operatorGroupPermissionModel struct {
 	Permission types.String `tfsdk:"permission"`
 }
 
+// operatorGroupPermissionIdentityModel is the resource identity of itrs-uptrends_operatorgroup_permission.
+type operatorGroupPermissionIdentityModel struct {
+	GroupID    types.String `tfsdk:"operatorgroup_id"`
+	Permission types.String `tfsdk:"permission"`
+}
+
+func (i operatorGroupPermissionIdentityModel) importID() string {
+	return fmt.Sprintf("%s:%s", i.GroupID.ValueString(), i.Permission.ValueString())
+}
+
+func (m operatorGroupPermissionModel) identity() operatorGroupPermissionIdentityModel {
+	return operatorGroupPermissionIdentityModel{
+		GroupID:    m.GroupID,
+		Permission: m.Permission,
+	}
+}
+
 // Metadata returns the resource type name.
 func (r *operatorGroupPermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = "itrs-uptrends_operatorgroup_permission"
@@ -91,6 +109,7 @@ func (r *operatorGroupPermissionResource) Read(ctx context.Context, req resource
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, state.identity())...)
 
 	// Retrieve the current permission from the API.
 	currentPermission, err := r.client.GetOperatorGroupPermission(state.GroupID.ValueString())
@@ -145,6 +164,7 @@ func (r *operatorGroupPermissionResource) Create(ctx context.Context, req resour
 	// Save the state.
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, plan.identity())...)
 }
 
 func (r *operatorGroupPermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -173,9 +193,31 @@ func (r *operatorGroupPermissionResource) Delete(ctx context.Context, req resour
 	resp.State.RemoveResource(ctx)
 }
 
+func (r *operatorGroupPermissionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"operatorgroup_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "GUID of the operator group.",
+			},
+			"permission": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Name of the permission.",
+			},
+		},
+	}
+}
+
 func (r *operatorGroupPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity operatorGroupPermissionIdentityModel
+	importID, diags := importStateID(ctx, req, &identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Parse the composite ID.
-	parts := strings.Split(req.ID, ":")
+	parts := strings.Split(importID, ":")
 	if len(parts) != 2 {
 		resp.Diagnostics.AddError(
 			"Error importing resource",
@@ -190,5 +232,5 @@ func (r *operatorGroupPermissionResource) ImportState(ctx context.Context, req r
 	// Set the attributes in the state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("operatorgroup_id"), groupID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permission"), permission)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importID)...)
 }
@@ -80,6 +80,7 @@ func (r *operatorGroupResource) Create(ctx context.Context, req resource.CreateR
 	// Set the state.
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, plan.Id)...)
 }
 
 // Read is called to refresh the Terraform state.
@@ -92,6 +93,7 @@ func (r *operatorGroupResource) Read(ctx context.Context, req resource.ReadReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, state.Id)...)
 
 	// Call the underlying GetOperatorGroup method.
 	result, err, msg := r.client.GetOperatorGroup(state.Id.ValueString())
@@ -153,6 +155,7 @@ func (r *operatorGroupResource) Update(ctx context.Context, req resource.UpdateR
 	// Set the state in a single, consistent operation.
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, state.Id)...)
 }
 
 // Delete is called when the resource is destroyed.
@@ -177,7 +180,11 @@ func (r *operatorGroupResource) Delete(ctx context.Context, req resource.DeleteR
 	resp.State.RemoveResource(ctx)
 }
 
+func (r *operatorGroupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = guidIdentitySchema()
+}
+
 func (r *operatorGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
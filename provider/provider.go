@@ -9,12 +9,15 @@ import (
 	"github.com/itrs-group/terraform-provider-itrs-uptrends/constants"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource" // Added import for resources
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/itrs-group/terraform-provider-itrs-uptrends/client"
 	api "github.com/itrs-group/terraform-provider-itrs-uptrends/client/api"
+	converters "github.com/itrs-group/terraform-provider-itrs-uptrends/converters/monitor"
 )
 
 type UptrendsProvider struct {
@@ -30,6 +33,7 @@ type UptrendsProvider struct {
 	checkpoint                             *api.Checkpoint
 	alertDefinitionOperatorMembership      *api.AlertDefinitionOperatorMembership
 	alertDefinitionOperatorGroupMembership *api.AlertDefinitionOperatorGroupMembership
+	alertDefinitionAuthorization           *api.AlertDefinitionAuthorization
 	operatorGroupPermission                *api.OperatorGroupPermission
 	operatorPermission                     *api.OperatorPermission
 	vaultItem                              *api.VaultItem
@@ -37,12 +41,17 @@ type UptrendsProvider struct {
 	vaultSectionPermission                 *api.VaultSectionPermission
 	rumWebsite                             *api.RumWebsite
 	escalationLevelIntegration             *api.EscalationLevelIntegration
+	integration                            *api.Integration
+	alert                                  *api.Alert
+	monitorCheck                           *api.MonitorCheck
+	statistics                             *api.Statistics
+	monitorDefaults                        converters.MonitorDefaults
 }
 
-const defaultBaseUrl = "https://api.uptrends.com/v4"
-
 // Ensure UptrendsProvider implements the provider.Provider interface.
 var _ provider.Provider = &UptrendsProvider{}
+var _ provider.ProviderWithListResources = &UptrendsProvider{}
+var _ provider.ProviderWithFunctions = &UptrendsProvider{}
 
 func New() provider.Provider {
 	return &UptrendsProvider{}
@@ -71,7 +80,16 @@ func (p *UptrendsProvider) Schema(ctx context.Context, req provider.SchemaReques
 			},
 			"baseurl": schema.StringAttribute{
 				Optional:    true,
-				Description: "Custom API URL. Defaults to " + defaultBaseUrl + " if not provided.",
+				Description: "Custom API URL. Defaults to " + client.DefaultBaseUrl + " if not provided.",
+			},
+			"default_custom_fields": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Custom fields added to every monitor. Custom fields set on the monitor itself take precedence.",
+			},
+			"default_notes": schema.StringAttribute{
+				Optional:    true,
+				Description: "Notes used for every monitor that doesn't set notes itself. Removing it leaves the notes on existing monitors.",
 			},
 		},
 	}
@@ -79,10 +97,12 @@ func (p *UptrendsProvider) Schema(ctx context.Context, req provider.SchemaReques
 
 func (p *UptrendsProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var config struct {
-		Username types.String `tfsdk:"username"`
-		Password types.String `tfsdk:"password"`
-		Debug    types.Bool   `tfsdk:"debug"`
-		BaseUrl  types.String `tfsdk:"baseurl"`
+		Username            types.String `tfsdk:"username"`
+		Password            types.String `tfsdk:"password"`
+		Debug               types.Bool   `tfsdk:"debug"`
+		BaseUrl             types.String `tfsdk:"baseurl"`
+		DefaultCustomFields types.Map    `tfsdk:"default_custom_fields"`
+		DefaultNotes        types.String `tfsdk:"default_notes"`
 	}
 	diags := req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
@@ -95,11 +115,22 @@ func (p *UptrendsProvider) Configure(ctx context.Context, req provider.Configure
 
 	var baseAPIUrl string
 	if config.BaseUrl.IsNull() || config.BaseUrl.ValueString() == "" {
-		baseAPIUrl = defaultBaseUrl
+		baseAPIUrl = client.DefaultBaseUrl
 	} else {
 		baseAPIUrl = config.BaseUrl.ValueString()
 	}
 
+	p.monitorDefaults = converters.MonitorDefaults{}
+	if !config.DefaultCustomFields.IsNull() && !config.DefaultCustomFields.IsUnknown() {
+		resp.Diagnostics.Append(config.DefaultCustomFields.ElementsAs(ctx, &p.monitorDefaults.CustomFields, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if !config.DefaultNotes.IsNull() && !config.DefaultNotes.IsUnknown() {
+		p.monitorDefaults.Notes = config.DefaultNotes.ValueStringPointer()
+	}
+
 	var urlSource = client.NewUrlSource(baseAPIUrl)
 	platform := runtime.GOOS
 	var header = client.GenerateBasicAuthHeader(config.Username.ValueString(), config.Password.ValueString())
@@ -115,6 +146,7 @@ func (p *UptrendsProvider) Configure(ctx context.Context, req provider.Configure
 	p.alertDefinitionMonitorGroupMembership = api.NewAlertDefinitionMonitorGroupMembership(urlSource.AlertDefinitionURL(), header, constants.NewBuildVersion, platform)
 	p.alertDefinitionOperatorMembership = api.NewAlertDefinitionOperatorMembership(urlSource.AlertDefinitionURL(), header, constants.NewBuildVersion, platform)
 	p.alertDefinitionOperatorGroupMembership = api.NewAlertDefinitionOperatorGroupMembership(urlSource.AlertDefinitionURL(), header, constants.NewBuildVersion, platform)
+	p.alertDefinitionAuthorization = api.NewAlertDefinitionAuthorization(urlSource.AlertDefinitionURL(), header, constants.NewBuildVersion, platform)
 	p.operatorGroupPermission = api.NewOperatorGroupPermission(urlSource.OperatorGroupURL(), header, constants.NewBuildVersion, platform)
 	p.operatorPermission = api.NewOperatorPermission(urlSource.OperatorURL(), header, constants.NewBuildVersion, platform)
 	p.vaultItem = api.NewVaultItem(urlSource.VaultItemURL(), header, constants.NewBuildVersion, platform)
@@ -123,15 +155,21 @@ func (p *UptrendsProvider) Configure(ctx context.Context, req provider.Configure
 	p.checkpoint = api.NewCheckpoint(urlSource.CheckpointURL(), urlSource.CheckpointRegionURL(), header, constants.NewBuildVersion, platform)
 	p.rumWebsite = api.NewRumWebsite(urlSource.RumWebsiteURL(), header, constants.NewBuildVersion, platform)
 	p.escalationLevelIntegration = api.NewEscalationLevelIntegration(urlSource.AlertDefinitionURL(), header, constants.NewBuildVersion, platform)
+	p.integration = api.NewIntegration(urlSource.IntegrationURL(), header, constants.NewBuildVersion, platform)
+	p.alert = api.NewAlert(urlSource.AlertURL(), header, constants.NewBuildVersion, platform)
+	p.monitorCheck = api.NewMonitorCheck(urlSource.MonitorCheckURL(), header, constants.NewBuildVersion, platform)
+	p.statistics = api.NewStatistics(urlSource.StatisticsURL(), header, constants.NewBuildVersion, platform)
 }
 
 func (p *UptrendsProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		p.createAlertDefinition,
+		p.createAlertDefinitionEscalationLevelResource,
 		p.createAlertDefinitionOperatorMembershipResource,
 		p.createAlertDefinitionOperatorGroupMembershipResource,
 		p.createAlertDefinitionMonitorMember,
 		p.createAlertDefinitionMonitorGroupMembershipResource,
+		p.createAlertDefinitionAuthorizationResource,
 		p.createMembershipResource,
 		p.createMonitorgroupMembershipResource,
 		p.createMonitorGroupResource,
@@ -140,11 +178,13 @@ func (p *UptrendsProvider) Resources(ctx context.Context) []func() resource.Reso
 		p.createOperatorResource,
 		p.createOperatorGroupPermissionResource,
 		p.createOperatorPermissionResource,
+		p.createOperatorDutyScheduleResource,
 		p.createVaultItemResource,
 		p.createVaultSectionResource,
 		p.createVaultSectionPermissionResource,
 		p.createRumWebsiteResource,
 		p.createEscalationLevelIntegrationResource,
+		p.createIntegrationResource,
 	}
 }
 
@@ -153,6 +193,7 @@ func (p *UptrendsProvider) DataSources(_ context.Context) []func() datasource.Da
 	return []func() datasource.DataSource{
 		p.createOperatorDataSource,
 		p.createMonitorDataSource,
+		p.createMonitorsDataSource,
 		p.createOperatorGroupDataSource,
 		p.createMonitorGroupDataSource,
 		p.createAlertDefinitionDataSource,
@@ -161,6 +202,29 @@ func (p *UptrendsProvider) DataSources(_ context.Context) []func() datasource.Da
 		p.createCheckpointDataSource,
 		p.createCheckpointRegionDataSource,
 		p.createRumWebsiteDataSource,
+		p.createMobileDevicesDataSource,
+		p.createIntegrationDataSource,
+		p.createAlertsDataSource,
+		p.createMonitorChecksDataSource,
+		p.createStatisticsDataSource,
+	}
+}
+
+// ListResources defines the list resources implemented in the provider.
+func (p *UptrendsProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		p.createMonitorListResource,
+		p.createMonitorGroupListResource,
+		p.createOperatorListResource,
+		p.createAlertDefinitionListResource,
+		p.createVaultItemListResource,
+	}
+}
+
+// Functions defines the provider-defined functions implemented in the provider.
+func (p *UptrendsProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewRenderAlertMessageFunction,
 	}
 }
 
@@ -168,6 +232,10 @@ func (p *UptrendsProvider) createAlertDefinition() resource.Resource {
 	return NewAlertdefinitionResource(p.alertDefinition)
 }
 
+func (p *UptrendsProvider) createAlertDefinitionEscalationLevelResource() resource.Resource {
+	return NewAlertDefinitionEscalationLevelResource(p.alertDefinition)
+}
+
 func (p *UptrendsProvider) createAlertDefinitionOperatorMembershipResource() resource.Resource {
 	return NewAlertDefinitionOperatorMembershipResource(p.alertDefinitionOperatorMembership)
 }
@@ -197,7 +265,7 @@ func (p *UptrendsProvider) createMonitorGroupResource() resource.Resource {
 }
 
 func (p *UptrendsProvider) createMonitorResource() resource.Resource {
-	return NewMonitorResource(p.monitor)
+	return NewMonitorResource(p.monitor, p.monitorGroup, p.monitorGroupMembership, p.checkpoint, p.vaultItem, p.monitorDefaults)
 }
 
 func (p *UptrendsProvider) createOperatorGroupResource() resource.Resource {
@@ -220,6 +288,10 @@ func (p *UptrendsProvider) createMonitorDataSource() datasource.DataSource {
 	return NewMonitorDataSource(p.monitor)
 }
 
+func (p *UptrendsProvider) createMonitorsDataSource() datasource.DataSource {
+	return NewMonitorsDataSource(p.monitor, p.monitorGroupMembership)
+}
+
 func (p *UptrendsProvider) createOperatorGroupDataSource() datasource.DataSource {
 	return NewOperatorGroupDataSource(p.operatorGroup)
 }
@@ -252,6 +324,26 @@ func (p *UptrendsProvider) createRumWebsiteDataSource() datasource.DataSource {
 	return NewRumWebsiteDataSource(p.rumWebsite)
 }
 
+func (p *UptrendsProvider) createMobileDevicesDataSource() datasource.DataSource {
+	return NewMobileDevicesDataSource()
+}
+
+func (p *UptrendsProvider) createIntegrationDataSource() datasource.DataSource {
+	return NewIntegrationDataSource(p.integration)
+}
+
+func (p *UptrendsProvider) createAlertsDataSource() datasource.DataSource {
+	return NewAlertsDataSource(p.alert, p.monitorGroupMembership)
+}
+
+func (p *UptrendsProvider) createMonitorChecksDataSource() datasource.DataSource {
+	return NewMonitorChecksDataSource(p.monitorCheck)
+}
+
+func (p *UptrendsProvider) createStatisticsDataSource() datasource.DataSource {
+	return NewStatisticsDataSource(p.statistics)
+}
+
 func (p *UptrendsProvider) createOperatorPermissionResource() resource.Resource {
 	return NewOperatorPermissionResource(p.operatorPermission)
 }
@@ -264,6 +356,14 @@ func (p *UptrendsProvider) createVaultSectionResource() resource.Resource {
 	return NewVaultSectionResource(p.vaultSection)
 }
 
+func (p *UptrendsProvider) createOperatorDutyScheduleResource() resource.Resource {
+	return NewOperatorDutyScheduleResource(p.operator)
+}
+
+func (p *UptrendsProvider) createAlertDefinitionAuthorizationResource() resource.Resource {
+	return NewAlertDefinitionAuthorizationResource(p.alertDefinitionAuthorization)
+}
+
 func (p *UptrendsProvider) createVaultSectionPermissionResource() resource.Resource {
 	return NewVaultSectionPermissionResource(p.vaultSectionPermission)
 }
@@ -273,5 +373,29 @@ func (p *UptrendsProvider) createRumWebsiteResource() resource.Resource {
 }
 
 func (p *UptrendsProvider) createEscalationLevelIntegrationResource() resource.Resource {
-	return NewEscalationLevelIntegrationResource(p.escalationLevelIntegration)
+	return NewEscalationLevelIntegrationResource(p.escalationLevelIntegration, p.integration)
+}
+
+func (p *UptrendsProvider) createIntegrationResource() resource.Resource {
+	return NewIntegrationResource(p.integration)
+}
+
+func (p *UptrendsProvider) createMonitorListResource() list.ListResource {
+	return NewMonitorListResource(p.monitor, p.monitorGroup, p.monitorGroupMembership, p.monitorDefaults)
+}
+
+func (p *UptrendsProvider) createMonitorGroupListResource() list.ListResource {
+	return NewMonitorGroupListResource(p.monitorGroup)
+}
+
+func (p *UptrendsProvider) createOperatorListResource() list.ListResource {
+	return NewOperatorListResource(p.operator)
+}
+
+func (p *UptrendsProvider) createAlertDefinitionListResource() list.ListResource {
+	return NewAlertDefinitionListResource(p.alertDefinition)
+}
+
+func (p *UptrendsProvider) createVaultItemListResource() list.ListResource {
+	return NewVaultItemListResource(p.vaultItem)
 }
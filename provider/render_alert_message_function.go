@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/itrs-group/terraform-provider-itrs-uptrends/constants"
+	"github.com/itrs-group/terraform-provider-itrs-uptrends/helpers"
+)
+
+var _ function.Function = &renderAlertMessageFunction{}
+
+// NewRenderAlertMessageFunction constructs the render_alert_message function.
+func NewRenderAlertMessageFunction() function.Function {
+	return &renderAlertMessageFunction{}
+}
+
+// renderAlertMessageFunction previews an escalation level message or webhook body template.
+type renderAlertMessageFunction struct{}
+
+func (f *renderAlertMessageFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "render_alert_message"
+}
+
+func (f *renderAlertMessageFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Renders an alert message template with sample values.",
+		Description: "Replaces the {{...}} placeholders of an escalation level message or webhook body template, so templates can be checked in Terraform tests. " +
+			"Alert placeholders such as {{@alert.type}} take their value from values, or else from the example value of the placeholder. " +
+			"Alert placeholders that are not documented and integration variables must be set in values.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "template",
+				Description: "The message or body template.",
+			},
+			function.MapParameter{
+				Name:        "values",
+				Description: "Sample values, keyed by placeholder name without braces, e.g. \"@monitor.name\" or \"team\".",
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *renderAlertMessageFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var template string
+	var values map[string]string
+	resp.Error = req.Arguments.Get(ctx, &template, &values)
+	if resp.Error != nil {
+		return
+	}
+
+	// Keys for alert placeholders that aren't in the documented set are used as they are, as the set is incomplete.
+	rendered, missing := helpers.ReplacePlaceholders(template, func(name string) (string, bool) {
+		if value, ok := values[name]; ok {
+			return value, true
+		}
+		placeholder, ok := helpers.FindAlertPlaceholder(constants.AlertPlaceholders, name)
+		if !ok {
+			return "", false
+		}
+		for key, value := range values {
+			if strings.EqualFold(key, placeholder.Name) {
+				return value, true
+			}
+		}
+		return placeholder.Example, true
+	})
+	if len(missing) > 0 {
+		var problems, variables []string
+		for _, name := range uniqueStrings(missing) {
+			if strings.HasPrefix(name, "@") {
+				problems = append(problems, unknownAlertPlaceholderText(name)+" Add it to values to render it.")
+			} else {
+				variables = append(variables, name)
+			}
+		}
+		if len(variables) > 0 {
+			problems = append(problems, fmt.Sprintf("No value for the variables %s. Add them to values.", strings.Join(variables, ", ")))
+		}
+		resp.Error = function.NewArgumentFuncError(1, strings.Join(problems, "\n"))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, rendered)
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// guidIdentityModel is the resource identity of objects that are identified by their GUID alone.
+type guidIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// guidIdentitySchema returns the identity schema for guidIdentityModel.
+func guidIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "GUID of the object in Uptrends.",
+			},
+		},
+	}
+}
+
+// setGuidIdentity stores the GUID of the object as the resource identity.
+func setGuidIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id types.String) diag.Diagnostics {
+	return setIdentity(ctx, identity, guidIdentityModel{ID: id})
+}
+
+// setIdentity stores model as the resource identity. Responses of resources without an identity schema are left alone.
+func setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, model any) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+	return identity.Set(ctx, model)
+}
+
+// compositeIdentity is the structured identity of a resource whose import ID joins several values with colons.
+type compositeIdentity interface {
+	importID() string
+}
+
+// importStateID returns the import ID of the request. When the import block has an identity instead of an id,
+// the identity is read into identity and the equivalent import ID is returned, so both forms share one parser.
+func importStateID(ctx context.Context, req resource.ImportStateRequest, identity compositeIdentity) (string, diag.Diagnostics) {
+	if req.ID != "" || req.Identity == nil {
+		return req.ID, nil
+	}
+	diags := req.Identity.Get(ctx, identity)
+	return identity.importID(), diags
+}
@@ -126,6 +126,7 @@ func (r *rumWebsiteResource) Create(ctx context.Context, req resource.CreateRequ
 	// Set the state.
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, plan.Id)...)
 }
 
 // Read is called to refresh the Terraform state.
@@ -138,6 +139,7 @@ func (r *rumWebsiteResource) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, state.Id)...)
 
 	// Call the underlying GetRumWebsite method.
 	result, msg, err := r.client.GetRumWebsite(state.Id.ValueString())
@@ -213,6 +215,7 @@ func (r *rumWebsiteResource) Update(ctx context.Context, req resource.UpdateRequ
 	// Set the state in a single, consistent operation.
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, state.Id)...)
 }
 
 // Delete is called when the resource is destroyed.
@@ -237,7 +240,11 @@ func (r *rumWebsiteResource) Delete(ctx context.Context, req resource.DeleteRequ
 	resp.State.RemoveResource(ctx)
 }
 
+func (r *rumWebsiteResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = guidIdentitySchema()
+}
+
 func (r *rumWebsiteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	interfaces "github.com/itrs-group/terraform-provider-itrs-uptrends/client/interfaces"
+	models "github.com/itrs-group/terraform-provider-itrs-uptrends/client/models"
+)
+
+// defaultStatisticsDimension is the dimension used when none is configured.
+const defaultStatisticsDimension = "Day"
+
+var (
+	_ datasource.DataSource                   = &statisticsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &statisticsDataSource{}
+)
+
+// NewStatisticsDataSource constructs the statistics data source.
+func NewStatisticsDataSource(client interfaces.IStatistics) datasource.DataSource {
+	return &statisticsDataSource{client: client}
+}
+
+type statisticsDataSource struct {
+	client interfaces.IStatistics
+}
+
+type statisticsPeriodModel struct {
+	Period                types.String  `tfsdk:"period"`
+	UptimePercentage      types.Float64 `tfsdk:"uptime_percentage"`
+	DowntimePercentage    types.Float64 `tfsdk:"downtime_percentage"`
+	AlertCount            types.Int64   `tfsdk:"alert_count"`
+	CheckCount            types.Int64   `tfsdk:"check_count"`
+	ConfirmedErrorCount   types.Int64   `tfsdk:"confirmed_error_count"`
+	UnconfirmedErrorCount types.Int64   `tfsdk:"unconfirmed_error_count"`
+	AverageTotalTime      types.Float64 `tfsdk:"average_total_time"`
+}
+
+type statisticsDataSourceModel struct {
+	ID               types.String  `tfsdk:"id"`
+	MonitorID        types.String  `tfsdk:"monitor_id"`
+	MonitorGroupID   types.String  `tfsdk:"monitorgroup_id"`
+	PresetPeriod     types.String  `tfsdk:"preset_period"`
+	Start            types.String  `tfsdk:"start"`
+	End              types.String  `tfsdk:"end"`
+	Dimension        types.String  `tfsdk:"dimension"`
+	UptimePercentage types.Float64 `tfsdk:"uptime_percentage"`
+	AlertCount       types.Int64   `tfsdk:"alert_count"`
+	CheckCount       types.Int64   `tfsdk:"check_count"`
+	AverageTotalTime types.Float64 `tfsdk:"average_total_time"`
+	Periods          types.List    `tfsdk:"periods"`
+}
+
+func (d *statisticsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_statistics"
+}
+
+func (d *statisticsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Internal identifier for this data source instance.",
+		},
+		"monitor_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "GUID of the monitor to read the statistics of. Provide this or monitorgroup_id.",
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(
+					path.MatchRoot("monitor_id"),
+					path.MatchRoot("monitorgroup_id"),
+				),
+			},
+		},
+		"monitorgroup_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "GUID of the monitor group to read the statistics of. Provide this or monitor_id.",
+		},
+		"dimension": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: fmt.Sprintf("Length of the periods the statistics are split into: Day, Week or Month. Defaults to %s.", defaultStatisticsDimension),
+			Validators: []validator.String{
+				stringvalidator.OneOf("Day", "Week", "Month"),
+			},
+		},
+		"uptime_percentage": schema.Float64Attribute{
+			Computed:    true,
+			Description: "Uptime percentage over the whole period: the summed uptime of the dimension periods divided by their summed uptime and downtime. Null when there is no uptime or downtime.",
+		},
+		"alert_count": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Number of alerts over the whole period.",
+		},
+		"check_count": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Number of checks over the whole period.",
+		},
+		"average_total_time": schema.Float64Attribute{
+			Computed:    true,
+			Description: "Average of the average total time of the dimension periods that have checks, in milliseconds. Null when there were no checks.",
+		},
+		"periods": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "Statistics per dimension period, in the order returned by the API.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"period": schema.StringAttribute{
+						Computed:    true,
+						Description: "Identifier of the day, week or month.",
+					},
+					"uptime_percentage": schema.Float64Attribute{
+						Computed:    true,
+						Description: "Uptime percentage in the period.",
+					},
+					"downtime_percentage": schema.Float64Attribute{
+						Computed:    true,
+						Description: "Downtime percentage in the period.",
+					},
+					"alert_count": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Number of alerts in the period.",
+					},
+					"check_count": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Number of checks in the period.",
+					},
+					"confirmed_error_count": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Number of confirmed errors in the period.",
+					},
+					"unconfirmed_error_count": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Number of unconfirmed errors in the period.",
+					},
+					"average_total_time": schema.Float64Attribute{
+						Computed:    true,
+						Description: "Average total time of the checks in the period, in milliseconds.",
+					},
+				},
+			},
+		},
+	}
+	maps.Copy(attributes, timeRangeAttributes())
+
+	resp.Schema = schema.Schema{
+		Description: "Reads the uptime, alert and check statistics of a monitor or monitor group in a period.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *statisticsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data statisticsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateTimeRange(data.Start, data.End, &resp.Diagnostics)
+}
+
+func (d *statisticsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The statistics client was not configured. This is an internal error in the provider.")
+		return
+	}
+
+	var data statisticsDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dimension := defaultStatisticsDimension
+	if !data.Dimension.IsNull() && data.Dimension.ValueString() != "" {
+		dimension = data.Dimension.ValueString()
+	}
+	timeRange := timeRangeFromConfig(data.PresetPeriod, data.Start, data.End)
+
+	var statistics []models.StatisticsData
+	var statusCode int
+	var responseBody string
+	var err error
+	if !data.MonitorID.IsNull() {
+		data.ID = types.StringValue("monitor:" + data.MonitorID.ValueString())
+		statistics, statusCode, responseBody, err = d.client.GetMonitorStatistics(data.MonitorID.ValueString(), timeRange, dimension)
+	} else {
+		data.ID = types.StringValue("monitorgroup:" + data.MonitorGroupID.ValueString())
+		statistics, statusCode, responseBody, err = d.client.GetMonitorGroupStatistics(data.MonitorGroupID.ValueString(), timeRange, dimension)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading statistics", err.Error())
+		return
+	}
+	if statusCode >= 300 {
+		resp.Diagnostics.AddError(
+			"Failed to read statistics",
+			fmt.Sprintf("HTTP status code: %d with response body %v", statusCode, responseBody),
+		)
+		return
+	}
+
+	periods := make([]statisticsPeriodModel, 0, len(statistics))
+	var alertCount, checkCount, checkedPeriods int64
+	var uptime, downtime, totalTime float64
+	for _, period := range statistics {
+		attributes := period.Attributes
+		periods = append(periods, statisticsPeriodModel{
+			Period:                types.StringValue(period.Id),
+			UptimePercentage:      types.Float64Value(attributes.UptimePercentage),
+			DowntimePercentage:    types.Float64Value(attributes.DowntimePercentage),
+			AlertCount:            types.Int64Value(attributes.Alerts),
+			CheckCount:            types.Int64Value(attributes.Checks),
+			ConfirmedErrorCount:   types.Int64Value(attributes.ConfirmedErrors),
+			UnconfirmedErrorCount: types.Int64Value(attributes.UnconfirmedErrors),
+			AverageTotalTime:      types.Float64Value(attributes.TotalTime),
+		})
+
+		alertCount += attributes.Alerts
+		checkCount += attributes.Checks
+		uptime += attributes.Uptime
+		downtime += attributes.Downtime
+		if attributes.Checks > 0 {
+			checkedPeriods++
+			totalTime += attributes.TotalTime
+		}
+	}
+
+	periodsVal, diag := types.ListValueFrom(ctx, statisticsPeriodModelType(), periods)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Dimension = types.StringValue(dimension)
+	data.Periods = periodsVal
+	data.AlertCount = types.Int64Value(alertCount)
+	data.CheckCount = types.Int64Value(checkCount)
+	data.UptimePercentage = types.Float64Null()
+	data.AverageTotalTime = types.Float64Null()
+	if uptime+downtime > 0 {
+		data.UptimePercentage = types.Float64Value(uptime / (uptime + downtime) * 100)
+	}
+	// The API doesn't document how TotalTime relates to the number of checks, so every period counts equally.
+	if checkedPeriods > 0 {
+		data.AverageTotalTime = types.Float64Value(totalTime / float64(checkedPeriods))
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func statisticsPeriodModelType() attr.Type {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"period":                  types.StringType,
+			"uptime_percentage":       types.Float64Type,
+			"downtime_percentage":     types.Float64Type,
+			"alert_count":             types.Int64Type,
+			"check_count":             types.Int64Type,
+			"confirmed_error_count":   types.Int64Type,
+			"unconfirmed_error_count": types.Int64Type,
+			"average_total_time":      types.Float64Type,
+		},
+	}
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	models "github.com/itrs-group/terraform-provider-itrs-uptrends/client/models"
+	"github.com/itrs-group/terraform-provider-itrs-uptrends/constants"
+)
+
+// apiTimeLayout is the layout of the start and end times sent to the API.
+const apiTimeLayout = "2006-01-02T15:04:05"
+
+// timeRangeAttributes returns the preset_period, start and end attributes shared by the history data sources.
+func timeRangeAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"preset_period": schema.StringAttribute{
+			Optional:    true,
+			Description: fmt.Sprintf("Named period to read, e.g. Last24Hours. Conflicts with start and end. Defaults to %s when no time range is set.", constants.DefaultPresetPeriod),
+			Validators: []validator.String{
+				stringvalidator.OneOf(constants.PresetPeriods...),
+				stringvalidator.ConflictsWith(path.MatchRoot("start"), path.MatchRoot("end")),
+			},
+		},
+		"start": schema.StringAttribute{
+			Optional:    true,
+			Description: "Start of the period to read, as an RFC 3339 timestamp. Requires end.",
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRoot("end")),
+			},
+		},
+		"end": schema.StringAttribute{
+			Optional:    true,
+			Description: "End of the period to read, as an RFC 3339 timestamp. Requires start.",
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRoot("start")),
+			},
+		},
+	}
+}
+
+// validateTimeRange checks that start and end are RFC 3339 timestamps and that start is before end.
+// Unknown values are skipped, so it can be called from ValidateConfig.
+func validateTimeRange(start, end types.String, diags *diag.Diagnostics) {
+	startTime, startOk := parseTimeRangeValue(path.Root("start"), start, diags)
+	endTime, endOk := parseTimeRangeValue(path.Root("end"), end, diags)
+	if startOk && endOk && !startTime.Before(endTime) {
+		diags.AddAttributeError(path.Root("end"), "Invalid time range", "end must be after start.")
+	}
+}
+
+func parseTimeRangeValue(p path.Path, value types.String, diags *diag.Diagnostics) (time.Time, bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return time.Time{}, false
+	}
+	parsed, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(p, "Invalid timestamp", fmt.Sprintf("%q is not an RFC 3339 timestamp such as 2025-01-31T12:00:00Z.", value.ValueString()))
+		return time.Time{}, false
+	}
+	return parsed, true
+}
+
+// timeRangeFromConfig converts the configured time range to the query of the API, in UTC.
+// It falls back to the default preset period when neither a preset period nor start and end are set.
+func timeRangeFromConfig(presetPeriod, start, end types.String) models.TimeRange {
+	if !start.IsNull() && !end.IsNull() {
+		startTime, _ := time.Parse(time.RFC3339, start.ValueString())
+		endTime, _ := time.Parse(time.RFC3339, end.ValueString())
+		return models.TimeRange{
+			Start: startTime.UTC().Format(apiTimeLayout),
+			End:   endTime.UTC().Format(apiTimeLayout),
+		}
+	}
+	if !presetPeriod.IsNull() && presetPeriod.ValueString() != "" {
+		return models.TimeRange{PresetPeriod: presetPeriod.ValueString()}
+	}
+	return models.TimeRange{PresetPeriod: constants.DefaultPresetPeriod}
+}
+
+// parseAPITime parses a timestamp returned by the API, which may or may not include a time zone.
+func parseAPITime(value string) (time.Time, bool) {
+	for _, layout := range []string{time.RFC3339Nano, apiTimeLayout, "2006-01-02T15:04:05.999999999"} {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, true
+		}
+	}
+	return time.Time{}, false
+}
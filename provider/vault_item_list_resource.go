@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	interfaces "github.com/itrs-group/terraform-provider-itrs-uptrends/client/interfaces"
+	converters "github.com/itrs-group/terraform-provider-itrs-uptrends/converters/vault_item"
+)
+
+var _ list.ListResource = &vaultItemListResource{}
+
+// NewVaultItemListResource constructs the list resource for vault items.
+func NewVaultItemListResource(client interfaces.IVaultItem) list.ListResource {
+	return &vaultItemListResource{client: client}
+}
+
+type vaultItemListResource struct {
+	client interfaces.IVaultItem
+}
+
+type vaultItemListConfigModel struct {
+	NameRegex      types.String `tfsdk:"name_regex"`
+	VaultItemType  types.String `tfsdk:"vault_item_type"`
+	VaultSectionID types.String `tfsdk:"vault_section_id"`
+}
+
+func (r *vaultItemListResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "itrs-uptrends_vault_item"
+}
+
+func (r *vaultItemListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists the vault items in the account, optionally filtered. Secrets are never listed.",
+		Attributes: map[string]listschema.Attribute{
+			"name_regex": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list vault items whose name matches this regular expression.",
+			},
+			"vault_item_type": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list vault items of this type, e.g. CredentialSet.",
+			},
+			"vault_section_id": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list vault items in this vault section.",
+			},
+		},
+	}
+}
+
+func (r *vaultItemListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config vaultItemListConfigModel
+	diags := req.Config.Get(ctx, &config)
+	nameRegex, regexDiags := compileNameRegex(config.NameRegex)
+	diags.Append(regexDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	vaultItems, statusCode, responseBody, err := r.client.GetVaultItems()
+	if err != nil {
+		diags.AddError("Error listing vault items", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	if statusCode >= 300 {
+		diags.AddError(
+			"Failed to list vault items",
+			fmt.Sprintf("HTTP status code: %d with response body %v", statusCode, responseBody),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	results := make([]list.ListResult, 0, len(vaultItems))
+	for i := range vaultItems {
+		vaultItem := &vaultItems[i]
+		if nameRegex != nil && !nameRegex.MatchString(vaultItem.Name) {
+			continue
+		}
+		if !config.VaultItemType.IsNull() && vaultItem.VaultItemType != config.VaultItemType.ValueString() {
+			continue
+		}
+		if !config.VaultSectionID.IsNull() && vaultItem.VaultSectionGuid != config.VaultSectionID.ValueString() {
+			continue
+		}
+		result := newGuidListResult(ctx, req, vaultItem.VaultItemGuid, vaultItem.Name)
+		if req.IncludeResource {
+			state := converters.UpdateStateConversion(vaultItem)
+			result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+		}
+		results = append(results, result)
+	}
+	streamListResults(req, results, stream)
+}
@@ -288,6 +288,7 @@ func (r *vaultItemResource) Create(ctx context.Context, req resource.CreateReque
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 // Read implements the Terraform read operation
@@ -298,6 +299,7 @@ func (r *vaultItemResource) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, state.ID)...)
 	passwordVersion := types.Int64Null()
 	if !state.PasswordVersion.IsNull() {
 		passwordVersion = types.Int64Value(state.PasswordVersion.ValueInt64())
@@ -409,6 +411,7 @@ func (r *vaultItemResource) Update(ctx context.Context, req resource.UpdateReque
 	// Update state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 // Delete implements the Terraform delete operation
@@ -440,7 +443,11 @@ func (r *vaultItemResource) Delete(ctx context.Context, req resource.DeleteReque
 	resp.State.RemoveResource(ctx)
 }
 
+func (r *vaultItemResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = guidIdentitySchema()
+}
+
 func (r *vaultItemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
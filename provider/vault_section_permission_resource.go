@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -37,6 +38,28 @@ type vaultSectionPermissionModel struct {
 	OperatorGroupID   types.String `tfsdk:"operatorgroup_id"`
 }
 
+// vaultSectionPermissionIdentityModel is the resource identity of itrs-uptrends_vault_section_permission.
+type vaultSectionPermissionIdentityModel struct {
+	VaultSectionID  types.String `tfsdk:"vault_section_id"`
+	AuthorizationID types.String `tfsdk:"authorization_id"`
+}
+
+func (i vaultSectionPermissionIdentityModel) importID() string {
+	return fmt.Sprintf("%s:%s", i.VaultSectionID.ValueString(), i.AuthorizationID.ValueString())
+}
+
+// identity returns the resource identity of the vault section authorization, taking the authorization ID from the composite ID.
+func (m vaultSectionPermissionModel) identity() vaultSectionPermissionIdentityModel {
+	authorizationID := types.StringNull()
+	if parts := strings.Split(m.ID.ValueString(), ":"); len(parts) == 2 {
+		authorizationID = types.StringValue(parts[1])
+	}
+	return vaultSectionPermissionIdentityModel{
+		VaultSectionID:  m.VaultSectionID,
+		AuthorizationID: authorizationID,
+	}
+}
+
 func (r *vaultSectionPermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = "itrs-uptrends_vault_section_permission"
 }
@@ -102,6 +125,7 @@ func (r *vaultSectionPermissionResource) Read(ctx context.Context, req resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, state.identity())...)
 
 	parts := strings.Split(state.ID.ValueString(), ":")
 	if len(parts) != 2 {
@@ -180,6 +204,7 @@ func (r *vaultSectionPermissionResource) Create(ctx context.Context, req resourc
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, plan.identity())...)
 }
 
 func (r *vaultSectionPermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -212,8 +237,30 @@ func (r *vaultSectionPermissionResource) Delete(ctx context.Context, req resourc
 	resp.State.RemoveResource(ctx)
 }
 
+func (r *vaultSectionPermissionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"vault_section_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "GUID of the vault section.",
+			},
+			"authorization_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "ID of the authorization in the vault section.",
+			},
+		},
+	}
+}
+
 func (r *vaultSectionPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, ":")
+	var identity vaultSectionPermissionIdentityModel
+	importID, diags := importStateID(ctx, req, &identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts := strings.Split(importID, ":")
 	if len(parts) != 2 {
 		resp.Diagnostics.AddError(
 			"Error importing resource",
@@ -226,7 +273,7 @@ func (r *vaultSectionPermissionResource) ImportState(ctx context.Context, req re
 	authorizationID := parts[1]
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vault_section_id"), vaultSectionID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importID)...)
 
 	authorizations, err := r.client.GetVaultSectionAuthorizations(vaultSectionID)
 	if err != nil {
@@ -80,6 +80,7 @@ func (r *vaultSectionResource) Create(ctx context.Context, req resource.CreateRe
 	// Set the state.
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, plan.Id)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -92,6 +93,7 @@ func (r *vaultSectionResource) Read(ctx context.Context, req resource.ReadReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, state.Id)...)
 
 	// Call the underlying GetVaultSection method.
 	result, err, msg := r.client.GetVaultSection(state.Id.ValueString())
@@ -154,6 +156,7 @@ func (r *vaultSectionResource) Update(ctx context.Context, req resource.UpdateRe
 	// Set the state in a single, consistent operation.
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setGuidIdentity(ctx, resp.Identity, state.Id)...)
 }
 
 // Delete removes the vault_section resource.
@@ -177,7 +180,11 @@ func (r *vaultSectionResource) Delete(ctx context.Context, req resource.DeleteRe
 	resp.State.RemoveResource(ctx)
 }
 
+func (r *vaultSectionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = guidIdentitySchema()
+}
+
 func (r *vaultSectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }